@@ -0,0 +1,370 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// almostLockedSet is a group of n undetermined cells of a house holding n+1
+// candidates between them.  Should any one of those values be removed, the
+// remaining n values must all be placed in the cells.
+type almostLockedSet struct {
+	cells     []Location
+	digits    []int
+	house     House
+	locations map[int][]Location // the cells holding each value when found
+}
+
+// digitCells returns the cells of an almost locked set holding value.
+func (b *Board) digitCells(a almostLockedSet, value int) []Location {
+	var rtnval []Location
+	for _, l := range a.cells {
+		if b.hasCandidate(l, value) {
+			rtnval = append(rtnval, l)
+		}
+	}
+	return rtnval
+}
+
+// live determines if an almost locked set still is one, as earlier
+// eliminations may have solved its cells or removed its values.
+func (b *Board) live(a almostLockedSet) bool {
+	var digits []int
+	for _, l := range a.cells {
+		if b.numPossibilities(l) == 0 {
+			return false
+		}
+		for _, v := range b.cellCandidates(l) {
+			if !containsInt(digits, v) {
+				digits = append(digits, v)
+			}
+		}
+	}
+	return len(digits) == len(a.cells)+1
+}
+
+// commonCells returns the cells of each almost locked set holding value.  Nil
+// is returned if one of the sets does not hold value.
+func (b *Board) commonCells(value int, sets ...almostLockedSet) []Location {
+	var rtnval []Location
+	for _, a := range sets {
+		cells := b.digitCells(a, value)
+		if len(cells) == 0 {
+			return nil
+		}
+		rtnval = append(rtnval, cells...)
+	}
+	return rtnval
+}
+
+// overlaps determines if two almost locked sets share a cell.
+func (a almostLockedSet) overlaps(other almostLockedSet) bool {
+	for _, l := range a.cells {
+		if containsLocation(other.cells, l) {
+			return true
+		}
+	}
+	return false
+}
+
+// alsCells caps the cells of an almost locked set, keeping the search small on
+// large boards without missing any set of a 9x9 board, whose sets have at most
+// eight cells.
+const alsCells = 8
+
+// almostLockedSets returns every almost locked set of the board of up to
+// alsCells cells, growing sets through each house a cell at a time.  A set
+// holding more values than the largest set could is grown no more, as adding
+// cells never removes values.  Sets whose cells are locked are still grown, as
+// an almost locked set may hold a locked set.  A set of cells lying in more
+// than one house is returned once.
+func (b *Board) almostLockedSets() []almostLockedSet {
+	var rtnval []almostLockedSet
+	found := make(map[string]bool)
+	for _, h := range b.houses() {
+		var sets []almostLockedSet
+		var unsolved []Location
+		for _, l := range b.houseLocations(h) {
+			if b.numPossibilities(l) > 0 {
+				unsolved = append(unsolved, l)
+			}
+		}
+		growSets(unsolved, min(alsCells, len(unsolved)-1), func(cells []Location) bool {
+			digits := b.unionCandidates(cells)
+			if len(digits) > alsCells+1 {
+				return false
+			}
+			if len(digits) != len(cells)+1 {
+				return true
+			}
+			key := ""
+			for _, l := range cells {
+				key += l.String()
+			}
+			if !found[key] {
+				found[key] = true
+				a := almostLockedSet{cells: cells, digits: digits, house: h}
+				a.locations = make(map[int][]Location)
+				for _, v := range a.digits {
+					a.locations[v] = b.digitCells(a, v)
+				}
+				sets = append(sets, a)
+			}
+			return true
+		})
+		// Smaller sets first, as when the sets were found size by size.
+		sort.SliceStable(sets, func(i, j int) bool {
+			return len(sets[i].cells) < len(sets[j].cells)
+		})
+		rtnval = append(rtnval, sets...)
+	}
+	return rtnval
+}
+
+// restrictedCommons returns the values of two almost locked sets which can be
+// placed in at most one of them, being held by both with every cell holding
+// the value in one seeing every cell holding it in the other.  As candidates
+// are only ever removed, the cells holding each value when the sets were found
+// are used.
+func (b *Board) restrictedCommons(a1 almostLockedSet, a2 almostLockedSet) []int {
+	var rtnval []int
+	if a1.overlaps(a2) {
+		return nil
+	}
+	for _, v := range a1.digits {
+		if !containsInt(a2.digits, v) {
+			continue
+		}
+		cells1, cells2 := a1.locations[v], a2.locations[v]
+		restricted := len(cells1) > 0 && len(cells2) > 0
+		for _, l := range cells1 {
+			restricted = restricted && b.seesAll(l, cells2)
+		}
+		if restricted {
+			rtnval = append(rtnval, v)
+		}
+	}
+	return rtnval
+}
+
+// alsDeduction describes a pattern made of almost locked sets.
+func alsDeduction(technique string, sets ...almostLockedSet) Deduction {
+	d := Deduction{Technique: technique}
+	for _, a := range sets {
+		d.Cells = append(d.Cells, a.cells...)
+		d.Houses = append(d.Houses, a.house)
+	}
+	return d
+}
+
+// FindALSXZ looks for two almost locked sets A and B sharing a restricted
+// common value x.  As x can only be placed in one of them, the other becomes a
+// locked set, so any other value z common to both must be placed in one of
+// them and is eliminated from every cell seeing all of their cells holding z.
+// When the sets share two restricted common values, both sets become locked
+// sets: each restricted common value is eliminated from the cells seeing all
+// of its cells in both sets, and every other value of each set from the cells
+// seeing all of its cells in that set.
+func (b *Board) FindALSXZ() []Deduction {
+	var rtnval []Deduction
+	sets := b.almostLockedSets()
+	for i := 0; i < len(sets); i++ {
+		for j := i + 1; j < len(sets); j++ {
+			a1, a2 := sets[i], sets[j]
+			rccs := b.restrictedCommons(a1, a2)
+			if len(rccs) == 0 || !b.live(a1) || !b.live(a2) {
+				continue
+			}
+			d := alsDeduction("ALS-XZ", a1, a2)
+			d.Digits = rccs
+			if len(rccs) == 1 {
+				for _, z := range a1.digits {
+					if z == rccs[0] || !containsInt(a2.digits, z) {
+						continue
+					}
+					zCells := b.commonCells(z, a1, a2)
+					d.Digits = []int{rccs[0], z}
+					if zCells == nil {
+						continue
+					}
+					if found := b.eliminateSeen(d, z, zCells); found != nil {
+						rtnval = append(rtnval, *found)
+					}
+				}
+				continue
+			}
+			// Find the cells of every value before eliminating any, as the
+			// eliminations may solve cells of the sets.
+			d.Technique = "Doubly Linked ALS-XZ"
+			var values []int
+			var seen [][]Location
+			for _, v := range rccs {
+				if cells := b.commonCells(v, a1, a2); cells != nil {
+					values, seen = append(values, v), append(seen, cells)
+				}
+			}
+			for _, a := range []almostLockedSet{a1, a2} {
+				for _, v := range a.digits {
+					if cells := b.commonCells(v, a); !containsInt(rccs, v) && cells != nil {
+						values, seen = append(values, v), append(seen, cells)
+					}
+				}
+			}
+			for i, v := range values {
+				if found := b.eliminateSeen(d, v, seen[i]); found != nil {
+					d = *found
+				}
+			}
+			if len(d.Eliminations) > 0 {
+				rtnval = append(rtnval, d)
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindALSXYWing looks for three almost locked sets A, B and C, where A and C
+// share a restricted common value x and B and C a different restricted common
+// value y.  C can hold at most one of x and y, so A or B becomes a locked set.
+// Any value z common to A and B, other than x and y, is then eliminated from
+// every cell seeing all of their cells holding z.
+func (b *Board) FindALSXYWing() []Deduction {
+	var rtnval []Deduction
+	sets := b.almostLockedSets()
+	rccs := make(map[[2]int][]int)
+	linked := make([][]int, len(sets))
+	for i := range sets {
+		for j := i + 1; j < len(sets); j++ {
+			if r := b.restrictedCommons(sets[i], sets[j]); len(r) > 0 {
+				rccs[[2]int{i, j}], rccs[[2]int{j, i}] = r, r
+				linked[i] = append(linked[i], j)
+				linked[j] = append(linked[j], i)
+			}
+		}
+	}
+	for c := range sets {
+		for i, a := range linked[c] {
+			for _, bIndex := range linked[c][i+1:] {
+				a1, a2 := sets[a], sets[bIndex]
+				if a1.overlaps(a2) {
+					continue
+				}
+				for _, x := range rccs[[2]int{a, c}] {
+					for _, y := range rccs[[2]int{bIndex, c}] {
+						if x == y || !b.live(a1) || !b.live(a2) || !b.live(sets[c]) {
+							continue
+						}
+						d := alsDeduction("ALS-XY-Wing", a1, a2, sets[c])
+						for _, z := range a1.digits {
+							if z == x || z == y || !containsInt(a2.digits, z) {
+								continue
+							}
+							zCells := b.commonCells(z, a1, a2)
+							d.Digits = []int{x, y, z}
+							if zCells == nil {
+								continue
+							}
+							if found := b.eliminateSeen(d, z, zCells); found != nil {
+								rtnval = append(rtnval, *found)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindDeathBlossom looks for a stem cell and, for each of its candidates, an
+// almost locked set (a petal) holding that value only in cells seeing the stem.
+// Whichever value the stem holds, its petal becomes a locked set.  A value z
+// held by every petal but not by the stem must then be placed within one of the
+// petals, and is eliminated from every cell seeing all of their cells holding
+// z.  Stems of two or three candidates are looked for.
+func (b *Board) FindDeathBlossom() []Deduction {
+	var rtnval []Deduction
+	sets := b.almostLockedSets()
+	for _, stem := range b.unsolvedLocations() {
+		candidates := b.cellCandidates(stem)
+		if len(candidates) < 2 || len(candidates) > 3 {
+			continue
+		}
+		// The possible petals for each candidate of the stem.
+		petals := make([][]almostLockedSet, len(candidates))
+		for i, v := range candidates {
+			for _, a := range sets {
+				if a.locations[v] != nil && !containsLocation(a.cells, stem) && b.seesAll(stem, a.locations[v]) {
+					petals[i] = append(petals[i], a)
+				}
+			}
+		}
+		for z := 1; z <= b.maxValue; z++ {
+			if containsInt(candidates, z) {
+				continue
+			}
+			zPetals := make([][]almostLockedSet, len(candidates))
+			for i := range petals {
+				for _, a := range petals[i] {
+					if a.locations[z] != nil {
+						zPetals[i] = append(zPetals[i], a)
+					}
+				}
+			}
+			var targets []Location
+			for _, l := range b.unsolvedLocations() {
+				if b.hasCandidate(l, z) {
+					targets = append(targets, l)
+				}
+			}
+			b.blossom(stem, candidates, z, zPetals, nil, targets, &rtnval)
+		}
+	}
+	return rtnval
+}
+
+// blossom chooses a petal for each remaining candidate of a death blossom
+// stem, then eliminates z from the cells seeing every cell of the petals
+// holding z.  Targets holds the cells seeing every such cell of the petals
+// chosen so far.
+func (b *Board) blossom(stem Location, candidates []int, z int, petals [][]almostLockedSet, chosen []almostLockedSet, targets []Location, rtnval *[]Deduction) {
+	if len(chosen) == len(candidates) {
+		for i, a := range chosen {
+			cells := b.digitCells(a, candidates[i])
+			if !b.live(a) || len(cells) == 0 || !b.seesAll(stem, cells) {
+				return
+			}
+		}
+		zCells := b.commonCells(z, chosen...)
+		if zCells == nil {
+			return
+		}
+		d := alsDeduction("Death Blossom", chosen...)
+		d.Cells = append([]Location{stem}, d.Cells...)
+		d.Digits = append(append([]int{}, candidates...), z)
+		if found := b.eliminateSeen(d, z, zCells); found != nil {
+			*rtnval = append(*rtnval, *found)
+		}
+		return
+	}
+	for _, a := range petals[len(chosen)] {
+		if !b.hasCandidate(stem, candidates[len(chosen)]) {
+			return
+		}
+		var remaining []Location
+		for _, l := range targets {
+			if b.seesAll(l, a.locations[z]) {
+				remaining = append(remaining, l)
+			}
+		}
+		if len(remaining) > 0 {
+			b.blossom(stem, candidates, z, petals, append(chosen, a), remaining, rtnval)
+		}
+	}
+}
+
+// FindALS looks for ALS-XZ, ALS-XY-Wings and Death Blossoms.
+func (b *Board) FindALS() []Deduction {
+	rtnval := b.FindALSXZ()
+	rtnval = append(rtnval, b.FindALSXYWing()...)
+	return append(rtnval, b.FindDeathBlossom()...)
+}
@@ -0,0 +1,144 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestAlmostLockedSets(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 5, []int{1, 3})
+		b.SetCandidates(5, 5, []int{2, 3})
+		found := false
+		for _, a := range b.almostLockedSets() {
+			if len(a.cells) == 2 && a.cells[0] == (Location{1, 5}) && a.cells[1] == (Location{5, 5}) {
+				found = true
+				if a.house != (House{RowHouse, 5}) || len(a.digits) != 3 {
+					t.Errorf("Unexpected almost locked set in %s of %v.", a.house, a.digits)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Almost locked set of (1, 5) and (5, 5) not found.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestAlmostLockedSetsHoldingLockedSet(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	// (1, 5) and (2, 5) are a locked pair, which (3, 5) makes an almost
+	// locked set.
+	b.SetCandidates(1, 5, []int{1, 2})
+	b.SetCandidates(2, 5, []int{1, 2})
+	b.SetCandidates(3, 5, []int{3, 4})
+	expected := []Location{{1, 5}, {2, 5}, {3, 5}}
+	found := false
+	for _, a := range b.almostLockedSets() {
+		found = found || sameLocations(a.cells, expected)
+	}
+	if !found {
+		t.Errorf("Almost locked set of %v not found.", expected)
+	}
+}
+
+// alsLargeBoard returns a 25x25 board where each of the first 24 cells of
+// row 1 holds its column and the next value, so the sets are the runs of
+// neighbouring cells.
+func alsLargeBoard(tb testing.TB) *Board {
+	b, e := NewBoard(5, NewBox, NewCell)
+	if e != nil {
+		tb.Fatal(e.Error())
+	}
+	for column := 1; column <= 24; column++ {
+		b.SetCandidates(column, 1, []int{column, column + 1})
+	}
+	return b
+}
+
+func TestAlmostLockedSetsLargeBoard(t *testing.T) {
+	sets := alsLargeBoard(t).almostLockedSets()
+	// Runs of 1 to alsCells cells.
+	expected := 0
+	for size := 1; size <= alsCells; size++ {
+		expected += 24 - size + 1
+	}
+	if len(sets) != expected {
+		t.Errorf("Expected %d almost locked sets, not %d.", expected, len(sets))
+	}
+}
+
+func BenchmarkAlmostLockedSetsLargeBoard(b *testing.B) {
+	board := alsLargeBoard(b)
+	for i := 0; i < b.N; i++ {
+		board.almostLockedSets()
+	}
+}
+
+func TestFindALSXZ(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(1, 5, []int{1, 3})
+		b.SetCandidates(5, 5, []int{2, 3})
+		deductions := b.FindALSXZ()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected an ALS-XZ.")
+		}
+		if deductions[0].Technique != "ALS-XZ" {
+			t.Errorf("Unexpected technique %s.", deductions[0].Technique)
+		}
+		if b.hasCandidate(Location{5, 1}, 2) {
+			t.Errorf("2 not eliminated from (5, 1).")
+		}
+		if !b.hasCandidate(Location{5, 2}, 2) {
+			t.Errorf("2 eliminated from (5, 2) which does not see (1, 1).")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindALSXYWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(5, 1, []int{1, 3})
+		b.SetCandidates(1, 5, []int{2, 3})
+		deductions := b.FindALSXYWing()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected an ALS-XY-Wing.")
+		}
+		d := deductions[0]
+		if d.Technique != "ALS-XY-Wing" || len(d.Eliminations) != 1 || d.Eliminations[0] != (Candidate{Location{5, 5}, 3}) {
+			t.Errorf("Unexpected deduction: %s eliminating %v.", d.Technique, d.Eliminations)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindDeathBlossom(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(5, 1, []int{1, 3})
+		b.SetCandidates(1, 5, []int{2, 3})
+		deductions := b.FindDeathBlossom()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected a Death Blossom.")
+		}
+		if deductions[0].Technique != "Death Blossom" || deductions[0].Cells[0] != (Location{1, 1}) {
+			t.Errorf("Unexpected deduction: %s with stem %s.", deductions[0].Technique, deductions[0].Cells[0])
+		}
+		if b.hasCandidate(Location{5, 5}, 3) {
+			t.Errorf("3 not eliminated from (5, 5).")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
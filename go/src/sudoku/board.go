@@ -24,15 +24,23 @@ import (
 //   |        |        |        |
 //   ----------------------------
 // The sudoku board shape is square.  The board consists of boxes arranged to make a square.
-// A box consist of cells, arranged to make a square.  A cell holds a value, or a list
+// A box consist of cells, arranged to make a rectangle.  A cell holds a value, or a list
 // of possible values.  The number of cells in a box, is the same as the number of boxes
-// in a board.  The dimensions of a box in cells is also the same as the dimension of a board
-// in boxes.  Subsequently the number of rows, columns or boxes in a board or the number of
-// cells in a box must be a perfect square number.
+// in a board.  A board is as many boxes wide as its boxes are cells tall, and as many
+// boxes tall as its boxes are cells wide.  Usually the boxes are square, so the number of
+// rows, columns or boxes in a board or the number of cells in a box is a perfect square
+// number, though boxes of 3x2 or 4x3 cells make boards of 6 or 12.
 type Board struct {
-	boxes            map[int]BoxInterface
-	dimensionInBoxes int
-	maxValue         int
+	boxes          map[int]BoxInterface
+	boxWidth       int
+	boxHeight      int
+	maxValue       int
+	uniqueSolution bool
+	branch         bool
+	strategies     *Registry
+	steps          []Deduction
+	changes        *changeLog
+	alphabet       Alphabet
 }
 
 // NewBoard creates a Board object consisting of Boxes and Cells to represent a Sudoku board.
@@ -41,11 +49,24 @@ func NewBoard(dimensionSizeInBoxes int, boxConstructor func(sz int, CellConstruc
 		msg := fmt.Sprintf("Board size must be 2 or greater, not: %d!", dimensionSizeInBoxes)
 		return nil, errors.New(msg)
 	}
+	squareBoxConstructor := func(width int, height int, CellConstructor func(value int, maxValue int) (CellInterface, error)) (BoxInterface, error) {
+		return boxConstructor(width, CellConstructor)
+	}
+	return NewRectangularBoard(dimensionSizeInBoxes, dimensionSizeInBoxes, squareBoxConstructor, CellConstructor)
+}
+
+// NewRectangularBoard creates a Board object whose boxes are boxWidth cells
+// wide and boxHeight cells tall, such as the 3x2 boxes of a 6x6 board.
+func NewRectangularBoard(boxWidth int, boxHeight int, boxConstructor func(width int, height int, CellConstructor func(value int, maxValue int) (CellInterface, error)) (BoxInterface, error), CellConstructor func(value int, maxValue int) (CellInterface, error)) (*Board, error) {
+	if boxWidth < 2 || boxHeight < 2 {
+		msg := fmt.Sprintf("Board size must be 2 or greater, not: %dx%d!", boxWidth, boxHeight)
+		return nil, errors.New(msg)
+	}
 	var err error
-	var maxValue = dimensionSizeInBoxes * dimensionSizeInBoxes
-	rtnval := &Board{make(map[int]BoxInterface), dimensionSizeInBoxes, maxValue}
+	var maxValue = boxWidth * boxHeight
+	rtnval := &Board{make(map[int]BoxInterface), boxWidth, boxHeight, maxValue, false, false, nil, nil, nil, ""}
 	for i := 1; i <= maxValue; i++ {
-		rtnval.boxes[i], err = boxConstructor(dimensionSizeInBoxes, CellConstructor)
+		rtnval.boxes[i], err = boxConstructor(boxWidth, boxHeight, CellConstructor)
 		if err != nil {
 			return nil, err
 		}
@@ -53,40 +74,54 @@ func NewBoard(dimensionSizeInBoxes int, boxConstructor func(sz int, CellConstruc
 	return rtnval, nil
 }
 
-// NewBoardInitialize creates a new board based on known values.
+// NewBoardInitialize creates a new board based on known values.  The shape of
+// the boxes is found from the number of rows by boxShape, so other shapes, such
+// as boxes taller than they are wide, need NewRectangularBoardInitialize.
 func NewBoardInitialize(boardValues [][]int) (*Board, error) {
 	numRows := len(boardValues)
 
-	if IsPerfectSquare(numRows) {
-		dimensionSizeInBoxes, _ := IntSquareRoot(numRows)
-		b, e := NewBoard(dimensionSizeInBoxes, NewBox, NewCell)
-		if e == nil {
-			for rowIndex, rowElement := range boardValues {
-				numColumns := len(rowElement)
-				if numColumns != numRows {
-					msg := fmt.Sprintf("Size of row(%d) is %d which does not match number of rows (%d)!",
-						(rowIndex + 1), numColumns, numRows)
-					return nil, errors.New(msg)
-				}
-				for colIndex, cellValue := range rowElement {
-					b.SetValue(colIndex+1, rowIndex+1, int(cellValue))
-				}
+	if boxWidth, boxHeight, ok := boxShape(numRows); ok {
+		return NewRectangularBoardInitialize(boxWidth, boxHeight, boardValues)
+	}
+	msg := fmt.Sprintf("No box shape for row count(%d)!", numRows)
+	return nil, errors.New(msg)
+}
+
+// NewRectangularBoardInitialize creates a new board based on known values,
+// whose boxes are boxWidth cells wide and boxHeight cells tall, such as the
+// 2x3 boxes of a 6x6 board.
+func NewRectangularBoardInitialize(boxWidth int, boxHeight int, boardValues [][]int) (*Board, error) {
+	numRows := len(boardValues)
+	if numRows != boxWidth*boxHeight {
+		msg := fmt.Sprintf("Row count(%d) does not match boxes of %dx%d!", numRows, boxWidth, boxHeight)
+		return nil, errors.New(msg)
+	}
+
+	b, e := NewRectangularBoard(boxWidth, boxHeight, NewRectangularBox, NewCell)
+	if e == nil {
+		for rowIndex, rowElement := range boardValues {
+			numColumns := len(rowElement)
+			if numColumns != numRows {
+				msg := fmt.Sprintf("Size of row(%d) is %d which does not match number of rows (%d)!",
+					(rowIndex + 1), numColumns, numRows)
+				return nil, errors.New(msg)
+			}
+			for colIndex, cellValue := range rowElement {
+				b.SetValue(colIndex+1, rowIndex+1, int(cellValue))
 			}
-			return b, nil
 		}
-		msg := fmt.Sprintf("Failed to create board!")
-		return nil, errors.New(msg)
+		return b, nil
 	}
-	msg := fmt.Sprintf("Non square row count(%d)!", numRows)
+	msg := fmt.Sprintf("Failed to create board!")
 	return nil, errors.New(msg)
 }
 
 func (b *Board) columnRowToBoxNum(column int, row int) (int, int, int) {
-	var baseColumn = (column + 2) / b.dimensionInBoxes
-	var offset = b.dimensionInBoxes * ((row - 1) / b.dimensionInBoxes)
+	var baseColumn = 1 + (column-1)/b.boxWidth
+	var offset = b.boxHeight * ((row - 1) / b.boxHeight)
 	var boxNum = baseColumn + offset
-	var boxColumn = 1 + ((column - 1) % b.dimensionInBoxes)
-	var boxRow = 1 + ((row - 1) % b.dimensionInBoxes)
+	var boxColumn = 1 + ((column - 1) % b.boxWidth)
+	var boxRow = 1 + ((row - 1) % b.boxHeight)
 	return boxNum, boxColumn, boxRow
 }
 
@@ -117,7 +152,7 @@ func (b *Board) GetValue(column int, row int) (int, error) {
 // GetRepresentation returns an 2d array of integers representing the current cell values.
 func (b *Board) GetRepresentation() ([][]int, error) {
 	// Create the 2d array
-	boardDimension := b.dimensionInBoxes * b.dimensionInBoxes
+	boardDimension := b.maxValue
 	board := make([][]int, boardDimension)
 	for col := 0; col < boardDimension; col++ {
 		board[col] = make([]int, boardDimension)
@@ -200,7 +235,9 @@ func (b *Board) findValuesBox(column int, row int, usedValue *set.IntSet) error
 
 // FindHiddenSingle looks at the box, column and row that the specified cell is part of
 // and attempts to determine the cell value.  If determination is not possible, then
-// it eliminates possible values from the possiblities list for that cell.
+// it eliminates possible values from the possiblities list for that cell.  Only the
+// values already placed in the cell's row, column and box are considered, see
+// FindHiddenSingleInHouse for values that fit in only one cell of a house.
 func (b *Board) FindHiddenSingle(column int, row int) bool {
 	cell, e := b.getCell(column, row)
 
@@ -215,11 +252,79 @@ func (b *Board) FindHiddenSingle(column int, row int) bool {
 		b.findValuesRow(row, usedValues)
 		b.findValuesBox(column, row, usedValues)
 
+		var eliminated []int
+		for _, v := range cell.GetCandidates().GetAllMembers() {
+			if usedValues.Contains(v) {
+				eliminated = append(eliminated, v)
+			}
+		}
+		b.logEliminated(Location{column, row}, eliminated)
 		return cell.DiscardAndSetValue(usedValues)
 	}
 	return false
 }
 
+// FindHiddenSingleRow looks to see if one of the candidates of a cell can not be
+// placed in any other cell of its row.  If so, the cell is set to that value.
+func (b *Board) FindHiddenSingleRow(column int, row int) bool {
+	subjectCell, subjectError := b.getCell(column, row)
+	if subjectError == nil {
+		others := make([]CellInterface, 0, b.maxValue-1)
+		for columnIndex := 1; columnIndex <= b.maxValue; columnIndex++ {
+			if columnIndex != column {
+				cell, e := b.getCell(columnIndex, row)
+				if e == nil {
+					others = append(others, cell)
+				}
+			}
+		}
+		v, found := hiddenSingle(subjectCell, others)
+		if found {
+			return subjectCell.SetValue(v) == nil
+		}
+	}
+	return false
+}
+
+// FindHiddenSingleColumn looks to see if one of the candidates of a cell can not be
+// placed in any other cell of its column.  If so, the cell is set to that value.
+func (b *Board) FindHiddenSingleColumn(column int, row int) bool {
+	subjectCell, subjectError := b.getCell(column, row)
+	if subjectError == nil {
+		others := make([]CellInterface, 0, b.maxValue-1)
+		for rowIndex := 1; rowIndex <= b.maxValue; rowIndex++ {
+			if rowIndex != row {
+				cell, e := b.getCell(column, rowIndex)
+				if e == nil {
+					others = append(others, cell)
+				}
+			}
+		}
+		v, found := hiddenSingle(subjectCell, others)
+		if found {
+			return subjectCell.SetValue(v) == nil
+		}
+	}
+	return false
+}
+
+// FindHiddenSingleBox looks to see if one of the candidates of a cell can not be
+// placed in any other cell of its box.  If so, the cell is set to that value.
+func (b *Board) FindHiddenSingleBox(column int, row int) bool {
+	boxNum, boxColumn, boxRow := b.columnRowToBoxNum(column, row)
+	box := b.boxes[boxNum]
+	return box.FindHiddenSingle(boxColumn, boxRow)
+}
+
+// FindHiddenSingleInHouse looks for a value that fits in only the specified cell
+// of its row, column or box (its houses).  If such a value is found the cell is
+// set to that value.
+func (b *Board) FindHiddenSingleInHouse(column int, row int) bool {
+	return b.FindHiddenSingleRow(column, row) ||
+		b.FindHiddenSingleColumn(column, row) ||
+		b.FindHiddenSingleBox(column, row)
+}
+
 // FindNakedPairRow looks for a Naked Pair in a row, and eliminates these
 // candidates from the other members of the row.
 func (b *Board) FindNakedPairRow(column int, row int) bool {
@@ -250,8 +355,11 @@ func (b *Board) FindNakedPairRow(column int, row int) bool {
 					if columnIndex != column && columnIndex != matchingCellColumn {
 						cell, e := b.getCell(columnIndex, row)
 						if e == nil {
+							numPossibilities := cell.NumPossibilities()
 							cell.DiscardAndSetValue(subjectCell.GetCandidates())
-							rtnval = true
+							if cell.NumPossibilities() != numPossibilities {
+								rtnval = true
+							}
 						}
 					}
 				}
@@ -291,8 +399,11 @@ func (b *Board) FindNakedPairColumn(column int, row int) bool {
 					if rowIndex != row && rowIndex != matchingCellRow {
 						cell, e := b.getCell(column, rowIndex)
 						if e == nil {
+							numPossibilities := cell.NumPossibilities()
 							cell.DiscardAndSetValue(subjectCell.GetCandidates())
-							rtnval = true
+							if cell.NumPossibilities() != numPossibilities {
+								rtnval = true
+							}
 						}
 					}
 				}
@@ -322,22 +433,25 @@ func (b *Board) FindNakedPair(column int, row int) bool {
 	return foundInRow || foundInColumn || foundInBox
 }
 
-// SinglePassSolve steps through all cells of the Sudoku board and attemps to
-// resolve the value for each cell.
+// SinglePassSolve applies the board's strategies in order, stopping once one
+// of them makes progress, and returns whether any did.  With the default
+// registry this is the easiest technique that makes progress.  The deductions
+// made are recorded as steps, see Steps.  On the copies
+// of the board made to follow the assumptions of forcing chains, only the
+// strategies no harder than branchDifficulty are applied.
 func (b *Board) SinglePassSolve() bool {
-	rtnval := false
-	for col := 1; col <= b.maxValue; col++ {
-		for row := 1; row <= b.maxValue; row++ {
-			if b.FindHiddenSingle(col, row) {
-				rtnval = true
-			}
-
-			if b.FindNakedPair(col, row) {
-				rtnval = true
-			}
+	b.startRecording()
+	for _, s := range b.Strategies().Strategies() {
+		if b.branch && s.Difficulty() > branchDifficulty {
+			continue
+		}
+		if deductions := s.Apply(b); len(deductions) > 0 {
+			b.record(deductions)
+			return true
 		}
 	}
-	return rtnval
+	b.record(nil)
+	return false
 }
 
 // AllCellsDetermined steps through every cell of the board to determine
@@ -414,18 +528,36 @@ func (b *Board) IsValid() (bool, error) {
 	return true, nil
 }
 
-// Solve keeps calling SingePassSolve till no more changes are made.  At this
-// point the puzzle may NOT be solved because advanced puzzles may require searching
-// a reduced problem space.
+// Solve keeps calling SingePassSolve till no more changes are made.  Advanced
+// puzzles may not be solved at this point, so the reduced problem space is
+// then searched depth first for a solution, recording as steps each guess on
+// the way to the solution followed by the values placed as a consequence.
+// False is returned if the puzzle has no solution.  The steps taken are
+// returned by Steps, or by SolveSteps.
 func (b *Board) Solve() bool {
 	// Keep passing over the puzzle till no more changes are made.
 	for b.SinglePassSolve() {
 	}
 
 	ok, _ := b.IsValid()
+	if ok && !b.AllCellsDetermined() {
+		var path []Deduction
+		ok = b.search(1, &path) == 1
+		if ok {
+			b.steps = append(b.steps, path...)
+		}
+	}
 	return b.AllCellsDetermined() && ok
 }
 
+// SolveSteps solves the board as Solve does, returning the steps taken along
+// with whether the puzzle was solved.
+func (b *Board) SolveSteps() ([]Deduction, bool) {
+	start := len(b.steps)
+	ok := b.Solve()
+	return b.steps[start:], ok
+}
+
 // Print sends an integer representation of the board to stdout.
 func (b *Board) Print() {
 	cnt := 4*b.maxValue + 1
@@ -434,7 +566,7 @@ func (b *Board) Print() {
 	for row := 1; row <= b.maxValue; row++ {
 		for col := 1; col <= b.maxValue; col++ {
 			v, _ := b.GetValue(col, row)
-			fmt.Printf("|%2d ", v)
+			fmt.Printf("|%2s ", b.Alphabet().Symbol(v))
 		}
 		fmt.Printf("|\n")
 		fmt.Printf("%s\n", rowDivider)
@@ -448,19 +580,19 @@ func (b *Board) PrintPossibilities() {
 	rowDivider := strings.Repeat("-", cnt)
 	fmt.Printf("%s\n", rowDivider)
 	for row := 1; row <= b.maxValue; row++ {
-		for start := 1; start < b.maxValue; start += b.dimensionInBoxes {
+		for start := 1; start < b.maxValue; start += b.boxWidth {
 			for col := 1; col <= b.maxValue; col++ {
 				cell, _ := b.getCell(col, row)
 				cellValue := cell.GetValue()
 				if 1 <= cellValue && cellValue <= b.maxValue {
-					stringValue := fmt.Sprintf("%d", cellValue)
-					repeatedString := strings.Repeat(stringValue, b.dimensionInBoxes)
+					stringValue := b.Alphabet().Symbol(cellValue)
+					repeatedString := strings.Repeat(stringValue, b.boxWidth)
 					fmt.Printf("|%s", repeatedString)
 				} else {
 					var buffer bytes.Buffer
-					for v := start; v < start+b.dimensionInBoxes; v++ {
+					for v := start; v < start+b.boxWidth; v++ {
 						if cell.Contains(v) {
-							buffer.WriteString(fmt.Sprintf("%d", v))
+							buffer.WriteString(b.Alphabet().Symbol(v))
 						} else {
 							buffer.WriteString(".")
 						}
@@ -474,6 +606,42 @@ func (b *Board) PrintPossibilities() {
 	}
 }
 
+// Clone returns a copy of the board, including the candidates of every
+// undetermined cell.  Changes to the copy do not affect the board.
+func (b *Board) Clone() (*Board, error) {
+	rtnval, e := NewRectangularBoard(b.boxWidth, b.boxHeight, NewRectangularBox, NewCell)
+	if e != nil {
+		return nil, e
+	}
+	rtnval.uniqueSolution = b.uniqueSolution
+	rtnval.strategies = b.strategies
+	rtnval.alphabet = b.alphabet
+	rtnval.restore(b.snapshot())
+	return rtnval, nil
+}
+
+// AssumeUniqueSolution tells the board whether the puzzle is known to have a
+// unique solution.  The uniqueness based techniques, such as unique rectangles,
+// are only used once the caller asserts this.
+func (b *Board) AssumeUniqueSolution(unique bool) {
+	b.uniqueSolution = unique
+}
+
+// Strategies returns the registry of strategies applied by SinglePassSolve,
+// which may be changed to enable, disable or reorder them.  Unless set with
+// SetStrategies, the board starts with the DefaultRegistry.
+func (b *Board) Strategies() *Registry {
+	if b.strategies == nil {
+		b.strategies = DefaultRegistry()
+	}
+	return b.strategies
+}
+
+// SetStrategies sets the registry of strategies applied by SinglePassSolve.
+func (b *Board) SetStrategies(r *Registry) {
+	b.strategies = r
+}
+
 // SetCandidates sets the candidate values for a specified cell.
 func (b *Board) SetCandidates(column int, row int, candidates []int) error {
 	subjectCell, subjectError := b.getCell(column, row)
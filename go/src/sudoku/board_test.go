@@ -3,6 +3,7 @@ package sudoku
 import (
 	"errors"
 	"fmt"
+	"math/rand"
 	"testing"
 )
 
@@ -30,6 +31,30 @@ var solutionBoard1 = [][]int{
 	{7, 6, 3, 4, 1, 8, 2, 5, 9},
 }
 
+var hiddenSingleBoard = [][]int{
+	{2, -1, -1, -1, 8, -1, 3, -1, -1},
+	{-1, 6, -1, -1, 7, -1, -1, 8, 4},
+	{-1, 3, -1, 5, -1, -1, 2, -1, 9},
+	{-1, -1, -1, 1, -1, 5, 4, -1, 8},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{4, -1, 2, 7, -1, 6, -1, -1, -1},
+	{3, -1, 1, -1, -1, 7, -1, 4, -1},
+	{7, 2, -1, -1, 4, -1, -1, 6, -1},
+	{-1, -1, 4, -1, 1, -1, -1, -1, 3},
+}
+
+var hiddenSingleSolution = [][]int{
+	{2, 4, 5, 9, 8, 1, 3, 7, 6},
+	{1, 6, 9, 2, 7, 3, 5, 8, 4},
+	{8, 3, 7, 5, 6, 4, 2, 1, 9},
+	{9, 7, 6, 1, 2, 5, 4, 3, 8},
+	{5, 1, 3, 4, 9, 8, 6, 2, 7},
+	{4, 8, 2, 7, 3, 6, 9, 5, 1},
+	{3, 9, 1, 6, 5, 7, 8, 4, 2},
+	{7, 2, 8, 3, 4, 9, 1, 6, 5},
+	{6, 5, 4, 8, 1, 2, 7, 9, 3},
+}
+
 func FakeNewBox(dimensionInCells int) (*Box, error) {
 	return nil, errors.New("intentional error condition created")
 }
@@ -128,6 +153,48 @@ func TestFindHiddenSingle(t *testing.T) {
 	}
 }
 
+func TestFindHiddenSingleInHouse(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Only (1, 1) of the top left box, and of column 1, can hold a 9.
+		b.SetValue(4, 2, 9)
+		b.SetValue(5, 3, 9)
+		b.SetValue(2, 5, 9)
+		b.SetValue(3, 7, 9)
+		for col := 1; col <= 9; col++ {
+			for row := 1; row <= 9; row++ {
+				b.FindHiddenSingle(col, row)
+			}
+		}
+		if b.FindHiddenSingleRow(1, 1) {
+			t.Errorf("Hidden single found in row 1 where 9 has more than one location.")
+		}
+		if !b.FindHiddenSingleInHouse(1, 1) {
+			t.Errorf("Failed to find hidden single at 1, 1.")
+		}
+		v, _ := b.GetValue(1, 1)
+		if v != 9 {
+			t.Errorf("Expected hidden single of 9 at 1, 1, instead %d!", v)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSolveHiddenSingles(t *testing.T) {
+	b, e := NewBoardInitialize(hiddenSingleBoard)
+	if e == nil {
+		for b.SinglePassSolve() {
+		}
+		board, _ := b.GetRepresentation()
+		if !compare2dArrays(hiddenSingleSolution, board) {
+			t.Error("Hidden singles did not solve the board.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
 func TestSinglePassSolve(t *testing.T) {
 	b, e := NewBoardInitialize(solvableBoard1)
 	if e == nil {
@@ -389,3 +456,199 @@ func TestNakedPair(t *testing.T) {
 		t.Errorf(e.Error())
 	}
 }
+
+// patternSolution returns a solved grid for a board whose boxes are width
+// cells wide and height cells tall, each row shifting the values of the one
+// above.
+func patternSolution(width int, height int) [][]int {
+	maxValue := width * height
+	grid := make([][]int, maxValue)
+	for r := range grid {
+		grid[r] = make([]int, maxValue)
+		for c := range grid[r] {
+			grid[r][c] = (width*(r%height)+r/height+c)%maxValue + 1
+		}
+	}
+	return grid
+}
+
+func TestColumnRowToBoxNum(t *testing.T) {
+	b, e := NewBoard(4, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	for _, test := range []struct{ column, row, box, boxColumn, boxRow int }{
+		{1, 1, 1, 1, 1}, {4, 4, 1, 4, 4}, {5, 1, 2, 1, 1}, {16, 1, 4, 4, 1}, {9, 6, 7, 1, 2}, {16, 16, 16, 4, 4},
+	} {
+		box, boxColumn, boxRow := b.columnRowToBoxNum(test.column, test.row)
+		if box != test.box || boxColumn != test.boxColumn || boxRow != test.boxRow {
+			t.Errorf("(%d, %d) mapped to box %d at (%d, %d).", test.column, test.row, box, boxColumn, boxRow)
+		}
+	}
+}
+
+func TestSolveSizes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, shape := range []struct{ width, height int }{{2, 2}, {3, 2}, {4, 3}, {4, 4}, {5, 5}} {
+		size := shape.width * shape.height
+		solution := patternSolution(shape.width, shape.height)
+		b, e := NewBoardInitialize(solution)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if ok, e := b.IsValid(); !ok || !b.AllCellsDetermined() {
+			t.Fatalf("Solution of size %d not valid: %v", size, e)
+		}
+
+		puzzle := make([][]int, len(solution))
+		for r := range solution {
+			puzzle[r] = append([]int{}, solution[r]...)
+			for c := range puzzle[r] {
+				if rng.Intn(3) == 0 {
+					puzzle[r][c] = -1
+				}
+			}
+		}
+		b, e = NewBoardInitialize(puzzle)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if !b.Solve() {
+			t.Errorf("Failed to solve board of size %d.", size)
+			continue
+		}
+		board, _ := b.GetRepresentation()
+		for r := range puzzle {
+			for c := range puzzle[r] {
+				if puzzle[r][c] != -1 && board[r][c] != puzzle[r][c] {
+					t.Errorf("Given at (%d, %d) changed on board of size %d.", c+1, r+1, size)
+				}
+			}
+		}
+		if ok, e := b.IsValid(); !ok {
+			t.Errorf("Solved board of size %d not valid: %v", size, e)
+		}
+	}
+}
+
+func TestRectangularBoard(t *testing.T) {
+	b, e := NewRectangularBoard(3, 2, NewRectangularBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	for _, test := range []struct{ column, row, box, boxColumn, boxRow int }{
+		{1, 1, 1, 1, 1}, {3, 2, 1, 3, 2}, {4, 1, 2, 1, 1}, {6, 2, 2, 3, 2}, {1, 3, 3, 1, 1}, {5, 4, 4, 2, 2}, {6, 6, 6, 3, 2},
+	} {
+		box, boxColumn, boxRow := b.columnRowToBoxNum(test.column, test.row)
+		if box != test.box || boxColumn != test.boxColumn || boxRow != test.boxRow {
+			t.Errorf("(%d, %d) mapped to box %d at (%d, %d).", test.column, test.row, box, boxColumn, boxRow)
+		}
+	}
+	locations := b.houseLocations(House{BoxHouse, 4})
+	expected := []Location{{4, 3}, {5, 3}, {6, 3}, {4, 4}, {5, 4}, {6, 4}}
+	if len(locations) != len(expected) {
+		t.Fatalf("Expected %v in box 4, not %v.", expected, locations)
+	}
+	for i, l := range locations {
+		if l != expected[i] || b.boxOf(l) != 4 {
+			t.Errorf("Expected %v in box 4, not %v.", expected, locations)
+			break
+		}
+	}
+
+	if _, e := NewRectangularBoard(1, 3, NewRectangularBox, NewCell); e == nil {
+		t.Error("Board with boxes 1 cell wide created.")
+	}
+	if _, e := NewBoardInitialize(make([][]int, 8)); e == nil {
+		t.Error("Board of 8 rows created without a box shape.")
+	}
+}
+
+func TestRectangularShapes(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, shape := range []struct{ width, height int }{{2, 4}, {4, 2}, {2, 5}, {3, 5}} {
+		b, e := NewRectangularBoard(shape.width, shape.height, NewRectangularBox, NewCell)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if b.maxValue != shape.width*shape.height {
+			t.Errorf("Board with %dx%d boxes holds %d values.", shape.width, shape.height, b.maxValue)
+		}
+
+		solution := patternSolution(shape.width, shape.height)
+		puzzle := make([][]int, len(solution))
+		for r := range solution {
+			puzzle[r] = append([]int{}, solution[r]...)
+			for c := range puzzle[r] {
+				if rng.Intn(3) == 0 {
+					puzzle[r][c] = -1
+				}
+			}
+		}
+		b, e = NewRectangularBoardInitialize(shape.width, shape.height, puzzle)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if !b.Solve() {
+			t.Errorf("Failed to solve board with %dx%d boxes.", shape.width, shape.height)
+			continue
+		}
+		if ok, e := b.IsValid(); !ok {
+			t.Errorf("Solved board with %dx%d boxes not valid: %v", shape.width, shape.height, e)
+		}
+		board, _ := b.GetRepresentation()
+		for r := range puzzle {
+			for c := range puzzle[r] {
+				if puzzle[r][c] != -1 && board[r][c] != puzzle[r][c] {
+					t.Errorf("Given at (%d, %d) changed with %dx%d boxes.", c+1, r+1, shape.width, shape.height)
+				}
+			}
+		}
+	}
+}
+
+func TestTallBoxBoard(t *testing.T) {
+	solution := patternSolution(2, 3)
+	b, e := NewRectangularBoardInitialize(2, 3, solution)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if ok, e := b.IsValid(); !ok || !b.AllCellsDetermined() {
+		t.Fatalf("Solution with 2x3 boxes not valid: %v", e)
+	}
+	for _, test := range []struct{ column, row, box, boxColumn, boxRow int }{
+		{1, 1, 1, 1, 1}, {2, 3, 1, 2, 3}, {3, 1, 2, 1, 1}, {6, 3, 3, 2, 3}, {1, 4, 4, 1, 1}, {6, 6, 6, 2, 3},
+	} {
+		box, boxColumn, boxRow := b.columnRowToBoxNum(test.column, test.row)
+		if box != test.box || boxColumn != test.boxColumn || boxRow != test.boxRow {
+			t.Errorf("(%d, %d) mapped to box %d at (%d, %d).", test.column, test.row, box, boxColumn, boxRow)
+		}
+	}
+	if wide, e := NewBoardInitialize(solution); e != nil {
+		t.Error(e.Error())
+	} else if ok, _ := wide.IsValid(); ok {
+		t.Error("Solution with 2x3 boxes valid with 3x2 boxes.")
+	}
+
+	puzzle := make([][]int, len(solution))
+	for r := range solution {
+		puzzle[r] = append([]int{}, solution[r]...)
+		puzzle[r][r] = -1
+		puzzle[r][5-r] = -1
+	}
+	b, e = NewRectangularBoardInitialize(2, 3, puzzle)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if !b.Solve() {
+		t.Fatal("Failed to solve board with 2x3 boxes.")
+	}
+	board, _ := b.GetRepresentation()
+	if !compare2dArrays(solution, board) {
+		t.Error("Board with 2x3 boxes solved incorrectly.")
+	}
+
+	if _, e := NewRectangularBoardInitialize(2, 3, make([][]int, 9)); e == nil {
+		t.Error("Board of 9 rows created with 2x3 boxes.")
+	}
+}
@@ -12,10 +12,13 @@ type BoxInterface interface {
 	IsValid() (bool, error)
 	SetValue(column int, row int, value int) error
 	FindNakedPair(boxColumn int, boxRow int) bool
+	FindHiddenSingle(boxColumn int, boxRow int) bool
 }
 
-// Box holds cells and meta data associated with its dimensionality.
-//   <--- dimension in Cells --->
+// Box holds cells and meta data associated with its dimensionality.  Boxes
+// are usually square, but may be a different number of cells wide than they
+// are tall.
+//   <----- width in Cells ----->
 //   ----------------------------
 //   |        |        |        |
 //   |  Cell  |  Cell  |  Cell  |
@@ -30,20 +33,27 @@ type BoxInterface interface {
 //   |        |        |        |
 //   ----------------------------
 type Box struct {
-	cells            map[int]CellInterface
-	dimensionInCells int
-	maxValue         int
+	cells    map[int]CellInterface
+	width    int
+	height   int
+	maxValue int
 }
 
-// NewBox creates a box object given a cell constructor.
+// NewBox creates a square box object given a cell constructor.
 func NewBox(dimensionInCells int, CellConstructor func(value int, maxValue int) (CellInterface, error)) (BoxInterface, error) {
-	if dimensionInCells < 2 {
-		msg := fmt.Sprintf("Box size must be 2 or greater, not: %d!", dimensionInCells)
+	return NewRectangularBox(dimensionInCells, dimensionInCells, CellConstructor)
+}
+
+// NewRectangularBox creates a box object width cells wide and height cells
+// tall given a cell constructor.
+func NewRectangularBox(width int, height int, CellConstructor func(value int, maxValue int) (CellInterface, error)) (BoxInterface, error) {
+	if width < 2 || height < 2 {
+		msg := fmt.Sprintf("Box size must be 2 or greater, not: %dx%d!", width, height)
 		return nil, errors.New(msg)
 	}
 	var err error
-	maxValue := dimensionInCells * dimensionInCells
-	rtnval := &Box{make(map[int]CellInterface), dimensionInCells, maxValue}
+	maxValue := width * height
+	rtnval := &Box{make(map[int]CellInterface), width, height, maxValue}
 	for i := 1; i <= maxValue; i++ {
 		rtnval.cells[i], err = CellConstructor(-1, maxValue)
 		if err != nil {
@@ -54,8 +64,8 @@ func NewBox(dimensionInCells int, CellConstructor func(value int, maxValue int)
 }
 
 func (b *Box) validLocation(column int, row int) bool {
-	if column >= 1 && column <= b.dimensionInCells {
-		if row >= 1 && row <= b.dimensionInCells {
+	if column >= 1 && column <= b.width {
+		if row >= 1 && row <= b.height {
 			return true
 		}
 	}
@@ -63,7 +73,7 @@ func (b *Box) validLocation(column int, row int) bool {
 }
 
 func (b *Box) colRowToCellNum(column int, row int) int {
-	return column + (row-1)*3
+	return column + (row-1)*b.width
 }
 
 // GetCell returns a reference to the desired cell within a Box.
@@ -126,7 +136,7 @@ func (b *Box) FindNakedPair(boxColumn int, boxRow int) bool {
 	rtnval := false
 	subjectCellNum := b.colRowToCellNum(boxColumn, boxRow)
 	subjectCell, subjectError := b.GetCellFromNum(subjectCellNum)
-	if subjectError == nil {
+	if subjectError == nil && subjectCell.NumPossibilities() == 2 {
 		// Look to see if there are any naked pairs.
 		matchCellNum := 0
 		matchCellCnt := 0
@@ -149,7 +159,9 @@ func (b *Box) FindNakedPair(boxColumn int, boxRow int) bool {
 				if i != subjectCellNum && i != matchCellNum {
 					c, e := b.GetCellFromNum(i)
 					if e == nil {
-						if c.DiscardAndSetValue(subjectCell.GetCandidates()) {
+						numPossibilities := c.NumPossibilities()
+						c.DiscardAndSetValue(subjectCell.GetCandidates())
+						if c.NumPossibilities() != numPossibilities {
 							rtnval = true
 						}
 					}
@@ -159,3 +171,26 @@ func (b *Box) FindNakedPair(boxColumn int, boxRow int) bool {
 	}
 	return rtnval
 }
+
+// FindHiddenSingle looks to see if one of the candidates of a cell can not be
+// placed in any other cell of the box.  If so, the cell is set to that value.
+func (b *Box) FindHiddenSingle(boxColumn int, boxRow int) bool {
+	subjectCellNum := b.colRowToCellNum(boxColumn, boxRow)
+	subjectCell, subjectError := b.GetCellFromNum(subjectCellNum)
+	if subjectError == nil {
+		others := make([]CellInterface, 0, b.maxValue-1)
+		for i := 1; i <= b.maxValue; i++ {
+			if i != subjectCellNum {
+				c, e := b.GetCellFromNum(i)
+				if e == nil {
+					others = append(others, c)
+				}
+			}
+		}
+		v, found := hiddenSingle(subjectCell, others)
+		if found {
+			return subjectCell.SetValue(v) == nil
+		}
+	}
+	return false
+}
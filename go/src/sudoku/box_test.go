@@ -94,3 +94,71 @@ func TestValidityOfBox(t *testing.T) {
 		t.Errorf(eBox.Error())
 	}
 }
+
+func TestBoxFindHiddenSingle(t *testing.T) {
+	box, e := NewBox(3, NewCell)
+	if box != nil {
+		box.SetValue(2, 1, 1)
+		for cellNum := 3; cellNum <= 9; cellNum++ {
+			c, _ := box.GetCellFromNum(cellNum)
+			c.SetCandidates([]int{2, 3, 4, 5, 6, 7, 8})
+		}
+		c, _ := box.GetCell(1, 1)
+		c.SetCandidates([]int{3, 9})
+		if box.FindHiddenSingle(1, 2) {
+			t.Errorf("Hidden single found for a cell without a unique candidate.")
+		}
+		if !box.FindHiddenSingle(1, 1) {
+			t.Errorf("Failed to find hidden single at 1, 1.")
+		}
+		if c.GetValue() != 9 {
+			t.Errorf("Expected hidden single of 9 at 1, 1, instead %d!", c.GetValue())
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestGetCellFromLargerBox(t *testing.T) {
+	b, e := NewBox(4, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	for row := 1; row <= 4; row++ {
+		for column := 1; column <= 4; column++ {
+			b.SetValue(column, row, column+4*(row-1))
+		}
+	}
+	for cellNum := 1; cellNum <= 16; cellNum++ {
+		c, e := b.GetCellFromNum(cellNum)
+		if e != nil || c.GetValue() != cellNum {
+			t.Errorf("Expected %d in cell %d.", cellNum, cellNum)
+		}
+	}
+	if ok, e := b.IsValid(); !ok {
+		t.Error(e.Error())
+	}
+}
+
+func TestRectangularBox(t *testing.T) {
+	b, e := NewRectangularBox(3, 2, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	for cellNum := 1; cellNum <= 6; cellNum++ {
+		c, _ := b.GetCellFromNum(cellNum)
+		c.SetValue(cellNum)
+	}
+	for _, test := range []struct{ column, row, value int }{{1, 1, 1}, {3, 1, 3}, {1, 2, 4}, {3, 2, 6}} {
+		c, e := b.GetCell(test.column, test.row)
+		if e != nil || c.GetValue() != test.value {
+			t.Errorf("Expected %d at (%d, %d).", test.value, test.column, test.row)
+		}
+	}
+	if _, e := b.GetCell(1, 3); e == nil {
+		t.Error("Cell below a box 2 cells tall returned.")
+	}
+	if _, e := NewRectangularBox(3, 1, NewCell); e == nil {
+		t.Error("Box 1 cell tall created.")
+	}
+}
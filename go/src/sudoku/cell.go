@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"set"
+	"sort"
 )
 
 // CellInterface describes the actions a cell can perform.
@@ -53,14 +54,13 @@ func (c *Cell) GetMaxValue() int {
 	return c.maxValue
 }
 
-// SetMaxValue sets the maximum value in the Cell object.
+// SetMaxValue sets the maximum value in the Cell object.  Any value of 2 or
+// more is accepted, the board checking the shape of its boxes.
 func (c *Cell) SetMaxValue(maxValue int) error {
 	var msg string
-	if maxValue > 1 {
-		if IsPerfectSquare(maxValue) {
-			c.maxValue = maxValue
-			return nil
-		}
+	if maxValue >= 2 {
+		c.maxValue = maxValue
+		return nil
 	}
 	msg = fmt.Sprintf("Invalid cell max value: %d!", maxValue)
 	return errors.New(msg)
@@ -74,7 +74,7 @@ func (c *Cell) SetValue(value int) error {
 	} else if value == -1 {
 		c.value = value
 		c.possibilities = set.NewIntSet()
-		for i := 1; i <= 9; i++ {
+		for i := 1; i <= c.maxValue; i++ {
 			c.possibilities.Add(i)
 		}
 	} else {
@@ -116,7 +116,6 @@ func (c *Cell) DiscardAndSetValue(usedValues *set.IntSet) bool {
 		}
 	}
 	if c.isNakedSingle() {
-		fmt.Println("NakedSingle")
 		val, e := c.possibilities.GetLastValue()
 		if e == nil {
 			c.SetValue(val)
@@ -178,3 +177,27 @@ func (c *Cell) SetCandidates(candidates []int) {
 	// if only 1 candidate, set value
 	c.DiscardAndSetValue(nil)
 }
+
+// hiddenSingle looks for a candidate of the subject cell which can not be placed
+// in any of the other cells of a row, column or box.  Such a candidate must be
+// the value of the subject cell.
+func hiddenSingle(subjectCell CellInterface, others []CellInterface) (int, bool) {
+	if subjectCell.Determined() {
+		return 0, false
+	}
+	candidates := subjectCell.GetCandidates().GetAllMembers()
+	sort.Ints(candidates)
+	for _, v := range candidates {
+		elsewhere := false
+		for _, c := range others {
+			if c.GetValue() == v || c.Contains(v) {
+				elsewhere = true
+				break
+			}
+		}
+		if !elsewhere {
+			return v, true
+		}
+	}
+	return 0, false
+}
@@ -40,7 +40,7 @@ func TestCellCreationFailure(t *testing.T) {
 		t.Errorf("ERROR: new cell object with invalid value should not be return.")
 	}
 
-	var maxValue = 8
+	var maxValue = 1
 	c, e = NewCell(value, maxValue)
 
 	if e == nil {
@@ -138,3 +138,15 @@ func TestSingleCandidate(t *testing.T) {
 		t.Errorf(e.Error())
 	}
 }
+
+func TestCellCandidatesForSize(t *testing.T) {
+	for _, maxValue := range []int{4, 16, 25} {
+		c, e := NewCell(-1, maxValue)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if c.NumPossibilities() != maxValue || !c.Contains(maxValue) || c.Contains(maxValue+1) {
+			t.Errorf("Expected candidates 1 to %d, not %v.", maxValue, c.GetCandidates().GetAllMembers())
+		}
+	}
+}
@@ -0,0 +1,275 @@
+package sudoku
+
+// linkKinds selects which links between candidates a chain may follow.  A
+// strong link joins two candidates of which at least one must be true, a weak
+// link two candidates of which at most one can be true.
+type linkKinds struct {
+	strongCell  bool // the two candidates of a bivalue cell
+	strongHouse bool // the only two places for a value within a house
+	weakCell    bool // two candidates of the same cell
+	weakHouse   bool // the same value in two cells seeing each other
+}
+
+// chainNode is a candidate assumed to be true (on) or false (off).
+type chainNode struct {
+	Candidate
+	on bool
+}
+
+// linkGraph holds the strong and weak links between the candidates of a board.
+type linkGraph struct {
+	candidates []Candidate
+	strong     map[Candidate][]Candidate
+	weak       map[Candidate][]Candidate
+}
+
+// candidates returns every candidate of the undetermined cells, or only those
+// for value if value is not zero, row by row.
+func (b *Board) candidates(value int) []Candidate {
+	var rtnval []Candidate
+	for _, l := range b.unsolvedLocations() {
+		for _, v := range b.cellCandidates(l) {
+			if value == 0 || v == value {
+				rtnval = append(rtnval, Candidate{l, v})
+			}
+		}
+	}
+	return rtnval
+}
+
+// housesOf returns the row, column and box holding a location.
+func (b *Board) housesOf(l Location) []House {
+	return []House{{RowHouse, l.Row}, {ColumnHouse, l.Column}, {BoxHouse, b.boxOf(l)}}
+}
+
+// weaklyLinked determines if two different candidates can not both be true,
+// being either in the same cell or the same value in cells seeing each other.
+func (b *Board) weaklyLinked(c1 Candidate, c2 Candidate) bool {
+	if c1 == c2 {
+		return false
+	}
+	return c1.Location == c2.Location || (c1.Value == c2.Value && b.sees(c1.Location, c2.Location))
+}
+
+// weakNeighbours returns every candidate weakly linked to a candidate.
+func (b *Board) weakNeighbours(c Candidate) []Candidate {
+	var rtnval []Candidate
+	for _, v := range b.cellCandidates(c.Location) {
+		if v != c.Value {
+			rtnval = append(rtnval, Candidate{c.Location, v})
+		}
+	}
+	for _, l := range b.unsolvedLocations() {
+		if b.sees(c.Location, l) && b.hasCandidate(l, c.Value) {
+			rtnval = append(rtnval, Candidate{l, c.Value})
+		}
+	}
+	return rtnval
+}
+
+// links builds the graph of links of the given kinds between the candidates
+// for value, or every candidate if value is zero.
+func (b *Board) links(kinds linkKinds, value int) linkGraph {
+	g := linkGraph{b.candidates(value), make(map[Candidate][]Candidate), make(map[Candidate][]Candidate)}
+	for _, c := range g.candidates {
+		if kinds.strongCell && b.numPossibilities(c.Location) == 2 {
+			g.strong[c] = append(g.strong[c], Candidate{c.Location, otherValue(b.cellCandidates(c.Location), c.Value)})
+		}
+		if kinds.strongHouse {
+			for _, h := range b.housesOf(c.Location) {
+				cells := b.candidateLocations(h, c.Value)
+				if len(cells) != 2 || b.valuePlaced(h, c.Value) {
+					continue
+				}
+				other := Candidate{cells[0], c.Value}
+				if other == c {
+					other.Location = cells[1]
+				}
+				if !containsCandidate(g.strong[c], other) {
+					g.strong[c] = append(g.strong[c], other)
+				}
+			}
+		}
+		for _, o := range g.candidates {
+			if !b.weaklyLinked(c, o) {
+				continue
+			}
+			if (kinds.weakCell && c.Location == o.Location) || (kinds.weakHouse && c.Location != o.Location) {
+				g.weak[c] = append(g.weak[c], o)
+			}
+		}
+	}
+	return g
+}
+
+// containsCandidate determines if a candidate is a member of candidates.
+func containsCandidate(candidates []Candidate, c Candidate) bool {
+	for _, o := range candidates {
+		if o == c {
+			return true
+		}
+	}
+	return false
+}
+
+// implications follows alternating links from start assumed false: a false
+// candidate makes the other end of its strong links true, and a true candidate
+// makes the other end of its weak links false.  Every node reached is returned
+// in the order reached, along with the node it was reached from.
+func (g linkGraph) implications(start Candidate) ([]chainNode, map[chainNode]chainNode) {
+	first := chainNode{start, false}
+	order := []chainNode{first}
+	parent := map[chainNode]chainNode{first: first}
+	for i := 0; i < len(order); i++ {
+		n := order[i]
+		next := g.strong[n.Candidate]
+		if n.on {
+			next = g.weak[n.Candidate]
+		}
+		for _, c := range next {
+			m := chainNode{c, !n.on}
+			if _, seen := parent[m]; !seen {
+				parent[m] = n
+				order = append(order, m)
+			}
+		}
+	}
+	return order, parent
+}
+
+// chainTo returns the candidates of the chain from the start of the
+// implications to end, starting with the false start candidate and
+// alternating false and true.
+func chainTo(parent map[chainNode]chainNode, end chainNode) []Candidate {
+	var rtnval []Candidate
+	for n := end; ; n = parent[n] {
+		rtnval = append([]Candidate{n.Candidate}, rtnval...)
+		if parent[n] == n {
+			return rtnval
+		}
+	}
+}
+
+// chainDeduction describes a chain, recording its cells and values.
+func chainDeduction(technique string, chain []Candidate) Deduction {
+	d := Deduction{Technique: technique, Chain: chain}
+	for _, c := range chain {
+		if !containsLocation(d.Cells, c.Location) {
+			d.Cells = append(d.Cells, c.Location)
+		}
+		if !containsInt(d.Digits, c.Value) {
+			d.Digits = append(d.Digits, c.Value)
+		}
+	}
+	return d
+}
+
+// findChains looks for alternating inference chains over the links of a graph,
+// each starting at one of starts assumed false and ending at a candidate which
+// must then be true.  Should the chain reach its own start, the start is true
+// and is placed (a discontinuous nice loop).  Otherwise one of the two ends of
+// the chain is true, so any candidate weakly linked to both ends is
+// eliminated.  If the end is also weakly linked to the start the chain closes
+// into a continuous nice loop, in which every weak link becomes strong, and any
+// candidate weakly linked to both ends of one of those links is eliminated.
+// If sameValue is true chains must end on the value they start with.
+func (b *Board) findChains(technique string, g linkGraph, starts []Candidate, sameValue bool) []Deduction {
+	var rtnval []Deduction
+	for _, start := range starts {
+		order, parent := g.implications(start)
+		for _, end := range order {
+			if !b.hasCandidate(start.Location, start.Value) {
+				break
+			}
+			if !end.on || (sameValue && end.Value != start.Value) {
+				continue
+			}
+			chain := chainTo(parent, end)
+			d := chainDeduction(technique, chain)
+			if end.Candidate == start {
+				d.Placements = []Candidate{start}
+				if b.place(start) == nil {
+					rtnval = append(rtnval, d)
+				}
+				break
+			}
+
+			// Pairs of candidates of which at least one is true.
+			pairs := [][2]Candidate{{start, end.Candidate}}
+			if b.weaklyLinked(start, end.Candidate) && distinctCandidates(chain) {
+				pairs = nil
+				for i := 1; i+1 < len(chain); i += 2 {
+					pairs = append(pairs, [2]Candidate{chain[i], chain[i+1]})
+				}
+				pairs = append(pairs, [2]Candidate{end.Candidate, start})
+			}
+			for _, pair := range pairs {
+				for _, c := range b.weakNeighbours(pair[0]) {
+					if containsCandidate(chain, c) || containsCandidate(d.Eliminations, c) {
+						continue
+					}
+					if b.weaklyLinked(c, pair[1]) {
+						d.Eliminations = append(d.Eliminations, c)
+					}
+				}
+			}
+			if len(d.Eliminations) > 0 {
+				b.eliminate(d.Eliminations)
+				rtnval = append(rtnval, d)
+			}
+		}
+	}
+	return rtnval
+}
+
+// distinctCandidates determines if no candidate appears twice in a chain.
+func distinctCandidates(chain []Candidate) bool {
+	for i, c := range chain {
+		if containsCandidate(chain[i+1:], c) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindXCycles looks for chains of a single value, alternating between strong
+// links, where a house holds only two places for the value, and weak links
+// between cells seeing each other.
+func (b *Board) FindXCycles() []Deduction {
+	var rtnval []Deduction
+	for v := 1; v <= b.maxValue; v++ {
+		g := b.links(linkKinds{strongHouse: true, weakHouse: true}, v)
+		rtnval = append(rtnval, b.findChains("X-Cycle", g, g.candidates, false)...)
+	}
+	return rtnval
+}
+
+// FindXYChains looks for chains of bivalue cells, each cell holding the value
+// its neighbour in the chain can not.  One of the two ends of the chain holds
+// the value it starts with, so the value is eliminated from every cell seeing
+// both ends.
+func (b *Board) FindXYChains() []Deduction {
+	g := b.links(linkKinds{strongCell: true, weakHouse: true}, 0)
+	var starts []Candidate
+	for _, c := range g.candidates {
+		if b.numPossibilities(c.Location) == 2 {
+			starts = append(starts, c)
+		}
+	}
+	return b.findChains("XY-Chain", g, starts, true)
+}
+
+// FindAICs looks for alternating inference chains mixing every kind of link:
+// bivalue cells, values with two places in a house, candidates of the same
+// cell and the same value in cells seeing each other.
+func (b *Board) FindAICs() []Deduction {
+	g := b.links(linkKinds{true, true, true, true}, 0)
+	return b.findChains("AIC", g, g.candidates, false)
+}
+
+// FindChains looks for X-Cycles, XY-Chains and alternating inference chains.
+func (b *Board) FindChains() []Deduction {
+	rtnval := b.FindXCycles()
+	rtnval = append(rtnval, b.FindXYChains()...)
+	return append(rtnval, b.FindAICs()...)
+}
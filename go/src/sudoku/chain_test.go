@@ -0,0 +1,106 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestFindXCycles(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 1, 1, []int{1, 5})
+		restrictColumnValue(b, 6, 1, []int{2, 9})
+		deductions := b.FindXCycles()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected an X-Cycle.")
+		}
+		d := deductions[0]
+		if d.Technique != "X-Cycle" || len(d.Chain) != 4 || len(d.Eliminations) != 1 {
+			t.Errorf("Unexpected deduction: %s of %d candidates with %d eliminations.", d.Technique, len(d.Chain), len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{1, 9}, 1) {
+			t.Errorf("1 not eliminated from (1, 9) which sees both ends of the chain.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindXCyclesPlacement(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 1, 1, []int{1, 3})
+		restrictColumnValue(b, 1, 1, []int{1, 3})
+		var placements []Candidate
+		for _, d := range b.FindXCycles() {
+			placements = append(placements, d.Placements...)
+		}
+		if len(placements) != 1 || placements[0] != (Candidate{Location{1, 1}, 1}) {
+			t.Errorf("Unexpected placements %v.", placements)
+		}
+		value, _ := b.GetValue(1, 1)
+		if value != 1 {
+			t.Errorf("Expected 1 at (1, 1), not %d.", value)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindXYChains(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(5, 1, []int{2, 3})
+		b.SetCandidates(5, 5, []int{3, 4})
+		b.SetCandidates(9, 5, []int{1, 4})
+		deductions := b.FindXYChains()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 XY-Chain, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "XY-Chain" || len(d.Cells) != 4 || len(d.Eliminations) != 2 {
+			t.Errorf("Unexpected deduction: %s of %d cells with %d eliminations.", d.Technique, len(d.Cells), len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{9, 1}, 1) || b.hasCandidate(Location{1, 5}, 1) {
+			t.Errorf("1 not eliminated from the cells seeing both ends of the chain.")
+		}
+		if !b.hasCandidate(Location{9, 2}, 1) {
+			t.Errorf("1 eliminated from (9, 2) which does not see (1, 1).")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindAICs(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 3, []int{1, 2})
+		restrictColumnValue(b, 1, 1, []int{1, 3})
+		var others []Candidate
+		for _, l := range b.houseLocations(House{BoxHouse, 1}) {
+			if l != (Location{1, 3}) && l != (Location{3, 1}) {
+				others = append(others, Candidate{l, 2})
+			}
+		}
+		b.eliminate(others)
+		if !b.hasCandidate(Location{3, 1}, 1) {
+			t.Fatalf("1 missing from (3, 1) before looking for chains.")
+		}
+		deductions := b.FindAICs()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected an AIC.")
+		}
+		if deductions[0].Technique != "AIC" {
+			t.Errorf("Unexpected technique %s.", deductions[0].Technique)
+		}
+		if b.hasCandidate(Location{3, 1}, 1) {
+			t.Errorf("1 not eliminated from (3, 1).")
+		}
+		if !b.hasCandidate(Location{1, 1}, 1) || !b.hasCandidate(Location{3, 1}, 2) {
+			t.Errorf("Candidate of the chain eliminated.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
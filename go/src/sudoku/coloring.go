@@ -0,0 +1,225 @@
+package sudoku
+
+// colorCluster is a group of cells joined by conjugate pairs for a value, that
+// is houses where the value can only be placed in two cells.  Alternate cells
+// of the chain are given alternate colors; every cell of one color holds the
+// value and no cell of the other does.
+type colorCluster struct {
+	cells  []Location
+	colors map[Location]int
+	houses []House
+}
+
+// conjugatePairs returns every pair of cells which are the only two places
+// for value within a house, along with the house.
+func (b *Board) conjugatePairs(value int) ([][2]Location, []House) {
+	var pairs [][2]Location
+	var houses []House
+	for _, h := range b.houses() {
+		if b.valuePlaced(h, value) {
+			continue
+		}
+		cells := b.candidateLocations(h, value)
+		if len(cells) == 2 {
+			pairs = append(pairs, [2]Location{cells[0], cells[1]})
+			houses = append(houses, h)
+		}
+	}
+	return pairs, houses
+}
+
+// colorClusters splits the conjugate pairs for a value into clusters, coloring
+// each cell of a cluster.  Clusters which can not be consistently colored are
+// dropped.
+func (b *Board) colorClusters(value int) []colorCluster {
+	pairs, houses := b.conjugatePairs(value)
+	links := make(map[Location][]int)
+	for i, p := range pairs {
+		links[p[0]] = append(links[p[0]], i)
+		links[p[1]] = append(links[p[1]], i)
+	}
+
+	var rtnval []colorCluster
+	visited := make(map[Location]bool)
+	for _, p := range pairs {
+		if visited[p[0]] {
+			continue
+		}
+		cluster := colorCluster{colors: map[Location]int{p[0]: 0}}
+		consistent := true
+		usedHouse := make(map[int]bool)
+		queue := []Location{p[0]}
+		visited[p[0]] = true
+		for len(queue) > 0 {
+			l := queue[0]
+			queue = queue[1:]
+			cluster.cells = append(cluster.cells, l)
+			for _, i := range links[l] {
+				other := pairs[i][0]
+				if other == l {
+					other = pairs[i][1]
+				}
+				if !usedHouse[i] {
+					usedHouse[i] = true
+					cluster.houses = append(cluster.houses, houses[i])
+				}
+				if c, ok := cluster.colors[other]; ok {
+					consistent = consistent && c != cluster.colors[l]
+					continue
+				}
+				cluster.colors[other] = 1 - cluster.colors[l]
+				visited[other] = true
+				queue = append(queue, other)
+			}
+		}
+		if consistent {
+			rtnval = append(rtnval, cluster)
+		}
+	}
+	return rtnval
+}
+
+// colored returns the cells of a cluster having the given color.
+func (c colorCluster) colored(color int) []Location {
+	var rtnval []Location
+	for _, l := range c.cells {
+		if c.colors[l] == color {
+			rtnval = append(rtnval, l)
+		}
+	}
+	return rtnval
+}
+
+// seesAny determines if a cell sees at least one of the listed cells.
+func (b *Board) seesAny(l Location, others []Location) bool {
+	for _, o := range others {
+		if b.sees(l, o) {
+			return true
+		}
+	}
+	return false
+}
+
+// eliminateCells eliminates value from each of the listed cells still holding
+// it, recording the eliminations in the deduction.  Nil is returned if nothing
+// could be eliminated.
+func (b *Board) eliminateCells(d Deduction, value int, cells []Location) *Deduction {
+	for _, l := range cells {
+		if b.hasCandidate(l, value) {
+			d.Eliminations = append(d.Eliminations, Candidate{l, value})
+		}
+	}
+	if len(d.Eliminations) == 0 {
+		return nil
+	}
+	b.eliminate(d.Eliminations)
+	return &d
+}
+
+// FindSimpleColoring colors the conjugate pairs of each value.  If two cells of
+// the same color see each other, that color can not hold the value, so the
+// value is eliminated from every cell of that color (a color wrap).  Any other
+// cell seeing cells of both colors can not hold the value either (a color
+// trap).
+func (b *Board) FindSimpleColoring() []Deduction {
+	var rtnval []Deduction
+	for v := 1; v <= b.maxValue; v++ {
+		for _, cluster := range b.colorClusters(v) {
+			d := Deduction{Cells: cluster.cells, Houses: cluster.houses, Digits: []int{v}}
+
+			// Color wrap.
+			wrapped := false
+			for color := 0; color <= 1 && !wrapped; color++ {
+				cells := cluster.colored(color)
+				for i := 0; i < len(cells) && !wrapped; i++ {
+					if b.seesAny(cells[i], cells[i+1:]) {
+						wrapped = true
+						d.Technique = "Color Wrap"
+						found := b.eliminateCells(d, v, cells)
+						if found != nil {
+							rtnval = append(rtnval, *found)
+						}
+					}
+				}
+			}
+			if wrapped {
+				continue
+			}
+
+			// Color trap.
+			var trapped []Location
+			for _, l := range b.unsolvedLocations() {
+				if _, inCluster := cluster.colors[l]; inCluster {
+					continue
+				}
+				if b.seesAny(l, cluster.colored(0)) && b.seesAny(l, cluster.colored(1)) {
+					trapped = append(trapped, l)
+				}
+			}
+			d.Technique = "Color Trap"
+			found := b.eliminateCells(d, v, trapped)
+			if found != nil {
+				rtnval = append(rtnval, *found)
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindMultiColoring compares pairs of color clusters of each value.  If a
+// color of one cluster sees both colors of another, it can not hold the value
+// and the value is eliminated from its cells.  Otherwise, if a color of one
+// cluster sees a color of another, one of the two opposite colors must hold the
+// value, so it is eliminated from any cell seeing both opposite colors.
+func (b *Board) FindMultiColoring() []Deduction {
+	var rtnval []Deduction
+	for v := 1; v <= b.maxValue; v++ {
+		clusters := b.colorClusters(v)
+		for i := 0; i < len(clusters); i++ {
+			for j := 0; j < len(clusters); j++ {
+				if i == j {
+					continue
+				}
+				c1, c2 := clusters[i], clusters[j]
+				d := Deduction{Technique: "Multi-Coloring", Digits: []int{v}}
+				d.Cells = append(append(d.Cells, c1.cells...), c2.cells...)
+				d.Houses = append(append(d.Houses, c1.houses...), c2.houses...)
+				for color1 := 0; color1 <= 1; color1++ {
+					cells1 := c1.colored(color1)
+					seesColor := [2]bool{}
+					for color2 := 0; color2 <= 1; color2++ {
+						for _, l := range c2.colored(color2) {
+							seesColor[color2] = seesColor[color2] || b.seesAny(l, cells1)
+						}
+					}
+					if seesColor[0] && seesColor[1] {
+						found := b.eliminateCells(d, v, cells1)
+						if found != nil {
+							rtnval = append(rtnval, *found)
+						}
+						continue
+					}
+					for color2 := 0; color2 <= 1; color2++ {
+						if !seesColor[color2] {
+							continue
+						}
+						opposite1, opposite2 := c1.colored(1-color1), c2.colored(1-color2)
+						var trapped []Location
+						for _, l := range b.unsolvedLocations() {
+							_, in1 := c1.colors[l]
+							_, in2 := c2.colors[l]
+							if !in1 && !in2 && b.seesAny(l, opposite1) && b.seesAny(l, opposite2) {
+								trapped = append(trapped, l)
+							}
+						}
+						found := b.eliminateCells(d, v, trapped)
+						if found != nil {
+							rtnval = append(rtnval, *found)
+						}
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
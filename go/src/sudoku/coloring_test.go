@@ -0,0 +1,100 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// restrictColumnValue removes value from every cell of a column except those
+// in the listed rows.
+func restrictColumnValue(b *Board, column int, value int, rows []int) {
+	for row := 1; row <= 9; row++ {
+		if !containsInt(rows, row) {
+			b.eliminate([]Candidate{{Location{column, row}, value}})
+		}
+	}
+}
+
+func TestColorTrap(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// (1, 1) and (7, 6) are one color, (7, 1) and (2, 6) the other.
+		restrictValue(b, 1, 5, []int{1, 7})
+		restrictColumnValue(b, 7, 5, []int{1, 6})
+		restrictValue(b, 6, 5, []int{2, 7})
+		deductions := b.FindSimpleColoring()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 coloring deduction, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Color Trap" || len(d.Cells) != 4 || len(d.Houses) != 3 {
+			t.Errorf("Unexpected deduction: %s with %d cells and %d houses.", d.Technique, len(d.Cells), len(d.Houses))
+		}
+		if len(d.Eliminations) != 4 {
+			t.Errorf("Expected 4 eliminations, not %d.", len(d.Eliminations))
+		}
+		for _, l := range []Location{{2, 2}, {2, 3}, {1, 4}, {1, 5}} {
+			if b.hasCandidate(l, 5) {
+				t.Errorf("5 not eliminated from %s.", l)
+			}
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestColorWrap(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// (1, 1), (5, 5) and (2, 2) are one color, and (1, 1) sees (2, 2).
+		restrictValue(b, 1, 5, []int{1, 5})
+		restrictColumnValue(b, 5, 5, []int{1, 5})
+		restrictValue(b, 5, 5, []int{2, 5})
+		restrictColumnValue(b, 2, 5, []int{2, 5})
+		deductions := b.FindSimpleColoring()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 coloring deduction, found %d.", len(deductions))
+		}
+		if deductions[0].Technique != "Color Wrap" {
+			t.Errorf("Unexpected technique: %s", deductions[0].Technique)
+		}
+		for _, l := range []Location{{1, 1}, {5, 5}, {2, 2}} {
+			if b.hasCandidate(l, 5) {
+				t.Errorf("5 not eliminated from %s.", l)
+			}
+		}
+		for _, l := range []Location{{5, 1}, {2, 5}} {
+			v, _ := b.GetValue(l.Column, l.Row)
+			if v != 5 && !b.hasCandidate(l, 5) {
+				t.Errorf("5 eliminated from %s.", l)
+			}
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestMultiColoring(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Two clusters, (1, 1) and (7, 1) along with (2, 3) and (8, 3).
+		restrictValue(b, 1, 5, []int{1, 7})
+		restrictValue(b, 3, 5, []int{2, 8})
+		if len(b.FindSimpleColoring()) != 0 {
+			t.Errorf("Simple coloring found where only multi-coloring applies.")
+		}
+		deductions := b.FindMultiColoring()
+		if len(deductions) == 0 {
+			t.Fatalf("Expected multi-coloring deductions.")
+		}
+		for _, l := range []Location{{1, 2}, {2, 2}, {3, 2}, {7, 2}, {8, 2}, {9, 2}} {
+			if b.hasCandidate(l, 5) {
+				t.Errorf("5 not eliminated from %s.", l)
+			}
+		}
+		if !b.hasCandidate(Location{4, 2}, 5) {
+			t.Errorf("5 eliminated from (4, 2).")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -0,0 +1,60 @@
+package sudoku
+
+import (
+	"fmt"
+	"set"
+)
+
+// Candidate is a possible value for the cell at a location.
+type Candidate struct {
+	Location
+	Value int
+}
+
+// String describes the candidate as value@(column, row).
+func (c Candidate) String() string {
+	return fmt.Sprintf("%d@%s", c.Value, c.Location)
+}
+
+// Deduction describes a pattern found by a solving technique along with the
+// values it allowed to be placed and the candidates it allowed to be
+// eliminated.  Cells, Houses and Digits hold the cells, houses and values
+// making up the pattern.  For fish, Houses holds the base lines and CoverHouses
+// the cover lines.  For chains, Chain holds the candidates of the chain in
+// order, alternately assumed false and true starting from the first.  The
+// deductions made by the solver are recorded in order as its steps.
+type Deduction struct {
+	Technique    string
+	Cells        []Location
+	Houses       []House
+	CoverHouses  []House
+	Digits       []int
+	Chain        []Candidate
+	Placements   []Candidate
+	Eliminations []Candidate
+}
+
+// hasCandidate determines if the cell at a location is undetermined and still
+// holds value as a candidate.
+func (b *Board) hasCandidate(l Location, value int) bool {
+	cell, e := b.getCell(l.Column, l.Row)
+	if e == nil {
+		return !cell.Determined() && cell.Contains(value)
+	}
+	return false
+}
+
+// eliminate discards each candidate from its cell.  As with the other
+// techniques, a cell is set to its value once a single candidate remains.
+func (b *Board) eliminate(candidates []Candidate) {
+	for _, c := range candidates {
+		cell, e := b.getCell(c.Column, c.Row)
+		if e == nil {
+			discard := set.NewIntSet()
+			discard.Add(c.Value)
+			if cell.DiscardAndSetValue(discard) {
+				b.logSolved(Candidate{c.Location, cell.GetValue()})
+			}
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestEliminate(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(4, 2, []int{1, 5, 7})
+		b.eliminate([]Candidate{{Location{4, 2}, 5}})
+		if b.hasCandidate(Location{4, 2}, 5) {
+			t.Errorf("Candidate 5 not eliminated at (4, 2).")
+		}
+		if !b.hasCandidate(Location{4, 2}, 7) {
+			t.Errorf("Candidate 7 unexpectedly eliminated at (4, 2).")
+		}
+
+		b.eliminate([]Candidate{{Location{4, 2}, 1}})
+		v, _ := b.GetValue(4, 2)
+		if v != 7 {
+			t.Errorf("Expected 7 to be set once it was the last candidate, instead %d.", v)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestCandidateString(t *testing.T) {
+	c := Candidate{Location{3, 8}, 6}
+	if c.String() != "6@(3, 8)" {
+		t.Errorf("Unexpected description of candidate: %s", c)
+	}
+}
@@ -0,0 +1,198 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// fishNames names fish by their size.
+var fishNames = map[int]string{2: "X-Wing", 3: "Swordfish", 4: "Jellyfish"}
+
+// fishLine holds a base line of a fish along with the cover line numbers
+// holding candidates for the fish value.
+type fishLine struct {
+	index  int
+	covers []int
+}
+
+// lineLocation returns the location where a base line crosses a cover line.
+func lineLocation(baseKind HouseKind, base int, cover int) Location {
+	if baseKind == RowHouse {
+		return Location{cover, base}
+	}
+	return Location{base, cover}
+}
+
+// FindFish looks for size rows (or columns), the base lines, in which every
+// candidate for a value lies within the same size columns (or rows), the cover
+// lines.  The value must then be placed in the cover lines where they cross
+// the base lines, so it is eliminated from the rest of the cover lines.  Size
+// 2 is an X-Wing, 3 a Swordfish and 4 a Jellyfish.
+//
+// If finned is true, base lines may also hold extra candidates, fins, outside
+// of the cover lines as long as the fins share a box.  Only the cells of the
+// cover lines which also see the fins can then be eliminated.  A finned fish
+// where a base line holds a single candidate in the cover lines is a sashimi
+// fish.
+func (b *Board) FindFish(size int, finned bool) []Deduction {
+	var rtnval []Deduction
+	name, ok := fishNames[size]
+	if !ok {
+		return nil
+	}
+	maxCandidates := size
+	if finned {
+		maxCandidates += max(b.boxWidth, b.boxHeight)
+	}
+
+	for _, baseKind := range []HouseKind{RowHouse, ColumnHouse} {
+		coverKind := ColumnHouse
+		if baseKind == ColumnHouse {
+			coverKind = RowHouse
+		}
+		for v := 1; v <= b.maxValue; v++ {
+			// Collect the candidate base lines for the value.
+			var lines []fishLine
+			for index := 1; index <= b.maxValue; index++ {
+				base := House{baseKind, index}
+				if b.valuePlaced(base, v) {
+					continue
+				}
+				line := fishLine{index: index}
+				for cover := 1; cover <= b.maxValue; cover++ {
+					if b.hasCandidate(lineLocation(baseKind, index, cover), v) {
+						line.covers = append(line.covers, cover)
+					}
+				}
+				if 2 <= len(line.covers) && len(line.covers) <= maxCandidates {
+					lines = append(lines, line)
+				}
+			}
+
+			combinations(len(lines), size, func(indices []int) bool {
+				baseLines := make([]fishLine, size)
+				var union []int
+				for i, index := range indices {
+					baseLines[i] = lines[index]
+					for _, cover := range lines[index].covers {
+						if !containsInt(union, cover) {
+							union = append(union, cover)
+						}
+					}
+				}
+				sort.Ints(union)
+				if len(union) == size {
+					d := b.fish(name, baseKind, coverKind, v, baseLines, union, 0)
+					if d != nil {
+						rtnval = append(rtnval, *d)
+					}
+				} else if finned && len(union) > size && len(union) <= maxCandidates {
+					combinations(len(union), size, func(coverIndices []int) bool {
+						covers := make([]int, size)
+						for i, index := range coverIndices {
+							covers[i] = union[index]
+						}
+						finBox := b.finBox(baseKind, baseLines, covers)
+						if finBox > 0 {
+							d := b.fish(name, baseKind, coverKind, v, baseLines, covers, finBox)
+							if d != nil {
+								rtnval = append(rtnval, *d)
+							}
+						}
+						return true
+					})
+				}
+				return true
+			})
+		}
+	}
+	return rtnval
+}
+
+// finBox returns the box holding every fin of a finned fish, that is every
+// base line candidate outside of the cover lines.  Zero is returned if the
+// fins are not all in the same box, or a base line holds no candidate in the
+// cover lines.
+func (b *Board) finBox(baseKind HouseKind, baseLines []fishLine, covers []int) int {
+	box := 0
+	for _, line := range baseLines {
+		covered := 0
+		for _, cover := range line.covers {
+			if containsInt(covers, cover) {
+				covered++
+				continue
+			}
+			finBox := b.boxOf(lineLocation(baseKind, line.index, cover))
+			if box != 0 && box != finBox {
+				return 0
+			}
+			box = finBox
+		}
+		if covered == 0 {
+			return 0
+		}
+	}
+	return box
+}
+
+// fish eliminates value from the cover lines outside of the base lines.  For a
+// finned fish, finBox is the box holding the fins, and only cells in that box
+// are eliminated from.  Nil is returned if nothing could be eliminated.
+func (b *Board) fish(name string, baseKind HouseKind, coverKind HouseKind, value int, baseLines []fishLine, covers []int, finBox int) *Deduction {
+	d := Deduction{Technique: name, Digits: []int{value}}
+	isBase := make(map[int]bool)
+	sashimi := false
+	for _, line := range baseLines {
+		isBase[line.index] = true
+		d.Houses = append(d.Houses, House{baseKind, line.index})
+		covered := 0
+		for _, cover := range line.covers {
+			d.Cells = append(d.Cells, lineLocation(baseKind, line.index, cover))
+			if containsInt(covers, cover) {
+				covered++
+			}
+		}
+		sashimi = sashimi || covered == 1
+	}
+	if finBox > 0 {
+		if sashimi {
+			d.Technique = "Sashimi " + name
+		} else {
+			d.Technique = "Finned " + name
+		}
+	}
+	for _, cover := range covers {
+		d.CoverHouses = append(d.CoverHouses, House{coverKind, cover})
+		for index := 1; index <= b.maxValue; index++ {
+			l := lineLocation(baseKind, index, cover)
+			if isBase[index] || (finBox > 0 && b.boxOf(l) != finBox) {
+				continue
+			}
+			if b.hasCandidate(l, value) {
+				d.Eliminations = append(d.Eliminations, Candidate{l, value})
+			}
+		}
+	}
+	if len(d.Eliminations) == 0 {
+		return nil
+	}
+	b.eliminate(d.Eliminations)
+	return &d
+}
+
+// FindXWing looks for X-Wings, including finned and sashimi X-Wings if finned
+// is true.
+func (b *Board) FindXWing(finned bool) []Deduction {
+	return b.FindFish(2, finned)
+}
+
+// FindSwordfish looks for Swordfish, including finned and sashimi Swordfish if
+// finned is true.
+func (b *Board) FindSwordfish(finned bool) []Deduction {
+	return b.FindFish(3, finned)
+}
+
+// FindJellyfish looks for Jellyfish, including finned and sashimi Jellyfish if
+// finned is true.
+func (b *Board) FindJellyfish(finned bool) []Deduction {
+	return b.FindFish(4, finned)
+}
@@ -0,0 +1,127 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// restrictValue removes value from every cell of a row except those in the
+// listed columns.
+func restrictValue(b *Board, row int, value int, columns []int) {
+	for col := 1; col <= 9; col++ {
+		if containsInt(columns, col) {
+			continue
+		}
+		b.eliminate([]Candidate{{Location{col, row}, value}})
+	}
+}
+
+func TestFindXWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 4, []int{3, 8})
+		restrictValue(b, 7, 4, []int{3, 8})
+		if len(b.FindFish(3, true)) != 0 {
+			t.Errorf("Swordfish found where only an X-Wing exists.")
+		}
+		deductions := b.FindXWing(false)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 X-Wing, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "X-Wing" || d.Digits[0] != 4 {
+			t.Errorf("Unexpected deduction: %s on %v", d.Technique, d.Digits)
+		}
+		if len(d.Houses) != 2 || d.Houses[0] != (House{RowHouse, 2}) || d.Houses[1] != (House{RowHouse, 7}) {
+			t.Errorf("Unexpected base lines: %v", d.Houses)
+		}
+		if len(d.CoverHouses) != 2 || d.CoverHouses[0] != (House{ColumnHouse, 3}) || d.CoverHouses[1] != (House{ColumnHouse, 8}) {
+			t.Errorf("Unexpected cover lines: %v", d.CoverHouses)
+		}
+		if len(d.Eliminations) != 14 {
+			t.Errorf("Expected 14 eliminations, not %d.", len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{3, 5}, 4) || b.hasCandidate(Location{8, 9}, 4) {
+			t.Errorf("4 not eliminated from the cover columns.")
+		}
+		if !b.hasCandidate(Location{3, 7}, 4) || !b.hasCandidate(Location{5, 5}, 4) {
+			t.Errorf("4 eliminated from outside of the cover columns.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindFinnedXWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 4, []int{3, 8})
+		restrictValue(b, 7, 4, []int{3, 8, 9})
+		if len(b.FindXWing(false)) != 0 {
+			t.Errorf("X-Wing found where only a finned X-Wing exists.")
+		}
+		deductions := b.FindXWing(true)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 finned X-Wing, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Finned X-Wing" {
+			t.Errorf("Unexpected technique: %s", d.Technique)
+		}
+		if len(d.Eliminations) != 2 {
+			t.Errorf("Expected 2 eliminations, not %d.", len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{8, 8}, 4) || b.hasCandidate(Location{8, 9}, 4) {
+			t.Errorf("4 not eliminated from the cells seeing the fin.")
+		}
+		if !b.hasCandidate(Location{8, 5}, 4) {
+			t.Errorf("4 eliminated from a cell not seeing the fin.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindSashimiXWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 4, []int{3, 8})
+		restrictValue(b, 7, 4, []int{3, 7, 9})
+		deductions := b.FindXWing(true)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 sashimi X-Wing, found %d.", len(deductions))
+		}
+		if deductions[0].Technique != "Sashimi X-Wing" {
+			t.Errorf("Unexpected technique: %s", deductions[0].Technique)
+		}
+		if b.hasCandidate(Location{8, 8}, 4) || b.hasCandidate(Location{8, 9}, 4) {
+			t.Errorf("4 not eliminated from the cells seeing the fins.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindSwordfish(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 7, []int{1, 5})
+		restrictValue(b, 5, 7, []int{5, 9})
+		restrictValue(b, 8, 7, []int{1, 9})
+		deductions := b.FindSwordfish(false)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 swordfish, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Swordfish" || len(d.Houses) != 3 || len(d.CoverHouses) != 3 {
+			t.Errorf("Unexpected deduction: %s in %v covered by %v", d.Technique, d.Houses, d.CoverHouses)
+		}
+		if len(d.Eliminations) != 18 {
+			t.Errorf("Expected 18 eliminations, not %d.", len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{5, 1}, 7) || b.hasCandidate(Location{9, 3}, 7) {
+			t.Errorf("7 not eliminated from the cover columns.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -0,0 +1,212 @@
+package sudoku
+
+// branches holds the boards reached by assuming candidates to be true, so the
+// consequences of each candidate are only worked out once.
+type branches struct {
+	board  *Board
+	boards map[Candidate]*Board
+}
+
+// newBranches prepares to assume candidates of the board.
+func (b *Board) newBranches() *branches {
+	return &branches{b, make(map[Candidate]*Board)}
+}
+
+// consistent determines if the board has not reached a contradiction: no value
+// is placed twice in a house, every undetermined cell holds candidates, and
+// every value not yet placed in a house can still be placed in it.
+func (b *Board) consistent() bool {
+	if ok, _ := b.IsValid(); !ok {
+		return false
+	}
+	for _, h := range b.houses() {
+		for v := 1; v <= b.maxValue; v++ {
+			if !b.valuePlaced(h, v) && len(b.candidateLocations(h, v)) == 0 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// assume places a candidate on a copy of the board, then calls SinglePassSolve
+// on the copy till no more changes are made.  Nil is returned if the copy
+// reaches a contradiction, in which case the candidate must be false.
+func (br *branches) assume(c Candidate) *Board {
+	if clone, done := br.boards[c]; done {
+		return clone
+	}
+	clone, e := br.board.Clone()
+	if e == nil {
+		clone.branch = true
+		e = clone.SetValue(c.Column, c.Row, c.Value)
+	}
+	if e == nil {
+		for clone.consistent() && clone.SinglePassSolve() {
+		}
+		if !clone.consistent() {
+			clone = nil
+		}
+	} else {
+		clone = nil
+	}
+	br.boards[c] = clone
+	return clone
+}
+
+// holds determines if a cell of the board either has value placed in it or
+// still holds value as a candidate.
+func (b *Board) holds(l Location, value int) bool {
+	v, e := b.GetValue(l.Column, l.Row)
+	return e == nil && (v == value || b.hasCandidate(l, value))
+}
+
+// agree assumes in turn each of a set of candidates, one of which must be
+// true.  Values placed by every assumption not ending in a contradiction are
+// placed, and candidates eliminated by all of them are eliminated.  Nil is
+// returned if nothing is agreed upon.
+func (br *branches) agree(d Deduction, assumptions []Candidate) *Deduction {
+	var outcomes []*Board
+	for _, c := range assumptions {
+		if o := br.assume(c); o != nil {
+			outcomes = append(outcomes, o)
+		}
+	}
+	if len(outcomes) == 0 {
+		// The board itself is contradictory.
+		return nil
+	}
+
+	b := br.board
+	for _, l := range b.unsolvedLocations() {
+		for _, v := range b.cellCandidates(l) {
+			placed, held := true, false
+			for _, o := range outcomes {
+				ov, _ := o.GetValue(l.Column, l.Row)
+				placed = placed && ov == v
+				held = held || o.holds(l, v)
+			}
+			if placed {
+				d.Placements = append(d.Placements, Candidate{l, v})
+			} else if !held {
+				d.Eliminations = append(d.Eliminations, Candidate{l, v})
+			}
+		}
+	}
+	var placements []Candidate
+	for _, c := range d.Placements {
+		if b.place(c) == nil {
+			placements = append(placements, c)
+		}
+	}
+	d.Placements = placements
+	var eliminations []Candidate
+	for _, c := range d.Eliminations {
+		if b.hasCandidate(c.Location, c.Value) {
+			eliminations = append(eliminations, c)
+		}
+	}
+	d.Eliminations = eliminations
+	if len(d.Placements) == 0 && len(d.Eliminations) == 0 {
+		return nil
+	}
+	b.eliminate(d.Eliminations)
+	return &d
+}
+
+// cellForcingChains assumes each candidate of every undetermined cell.  One of
+// them must be true, so whatever follows from all of them is true.
+func (br *branches) cellForcingChains() []Deduction {
+	var rtnval []Deduction
+	for _, l := range br.board.unsolvedLocations() {
+		d := Deduction{Technique: "Cell Forcing Chain", Cells: []Location{l}, Digits: br.board.cellCandidates(l)}
+		var assumptions []Candidate
+		for _, v := range d.Digits {
+			assumptions = append(assumptions, Candidate{l, v})
+		}
+		if found := br.agree(d, assumptions); found != nil {
+			rtnval = append(rtnval, *found)
+		}
+	}
+	return rtnval
+}
+
+// unitForcingChains assumes in turn each place for a value within a house.
+// The value must be placed in one of them, so whatever follows from all of
+// them is true.
+func (br *branches) unitForcingChains() []Deduction {
+	var rtnval []Deduction
+	b := br.board
+	for _, h := range b.houses() {
+		for v := 1; v <= b.maxValue; v++ {
+			cells := b.candidateLocations(h, v)
+			if len(cells) < 2 || b.valuePlaced(h, v) {
+				continue
+			}
+			d := Deduction{Technique: "Unit Forcing Chain", Cells: cells, Houses: []House{h}, Digits: []int{v}}
+			var assumptions []Candidate
+			for _, l := range cells {
+				assumptions = append(assumptions, Candidate{l, v})
+			}
+			if found := br.agree(d, assumptions); found != nil {
+				rtnval = append(rtnval, *found)
+			}
+		}
+	}
+	return rtnval
+}
+
+// nishio assumes each candidate in turn, eliminating those leading to a
+// contradiction.
+func (br *branches) nishio() []Deduction {
+	var rtnval []Deduction
+	b := br.board
+	for _, c := range b.candidates(0) {
+		if !b.hasCandidate(c.Location, c.Value) || br.assume(c) != nil {
+			continue
+		}
+		d := Deduction{Technique: "Nishio", Cells: []Location{c.Location}, Digits: []int{c.Value}}
+		d.Eliminations = []Candidate{c}
+		b.eliminate(d.Eliminations)
+		rtnval = append(rtnval, d)
+	}
+	return rtnval
+}
+
+// FindCellForcingChains assumes each candidate of a cell in turn on a copy of
+// the board, solving the copy as far as SinglePassSolve can.  Values placed or
+// eliminated on every copy not ending in a contradiction are placed or
+// eliminated on the board.
+func (b *Board) FindCellForcingChains() []Deduction {
+	return b.newBranches().cellForcingChains()
+}
+
+// FindUnitForcingChains assumes each place for a value within a house in turn
+// on a copy of the board, solving the copy as far as SinglePassSolve can.
+// Values placed or eliminated on every copy not ending in a contradiction are
+// placed or eliminated on the board.
+func (b *Board) FindUnitForcingChains() []Deduction {
+	return b.newBranches().unitForcingChains()
+}
+
+// FindNishio assumes each candidate in turn on a copy of the board, solving the
+// copy as far as SinglePassSolve can.  Candidates leading to a contradiction
+// are eliminated.
+func (b *Board) FindNishio() []Deduction {
+	return b.newBranches().nishio()
+}
+
+// FindForcingChains looks for cell forcing chains, then unit forcing chains,
+// then nishio eliminations, stopping once one of them makes progress.  The
+// consequences of each assumption are shared between them.
+func (b *Board) FindForcingChains() []Deduction {
+	br := b.newBranches()
+	rtnval := br.cellForcingChains()
+	if len(rtnval) == 0 {
+		rtnval = br.unitForcingChains()
+	}
+	if len(rtnval) == 0 {
+		rtnval = br.nishio()
+	}
+	return rtnval
+}
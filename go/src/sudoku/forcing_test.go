@@ -0,0 +1,89 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// forcingBoard returns a solved board but for (1, 1) and (2, 1) holding 1 and
+// 2, forcing (3, 1) to be 6 whichever holds 1.
+func forcingBoard(t *testing.T) *Board {
+	b, e := NewBoardInitialize(bugBoard())
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	b.SetCandidates(1, 1, []int{1, 2})
+	b.SetCandidates(2, 1, []int{1, 2})
+	b.SetCandidates(3, 1, []int{1, 2, 6})
+	return b
+}
+
+func TestClone(t *testing.T) {
+	b := forcingBoard(t)
+	clone, e := b.Clone()
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if !b.Equals(clone) {
+		t.Errorf("Clone does not equal the board.")
+	}
+	checkCandidates(t, clone, 3, 1, []int{1, 2, 6})
+	clone.SetValue(1, 1, 1)
+	if value, _ := b.GetValue(1, 1); value != -1 {
+		t.Errorf("Changing the clone changed the board.")
+	}
+}
+
+func TestFindCellForcingChains(t *testing.T) {
+	b := forcingBoard(t)
+	deductions := b.FindCellForcingChains()
+	if len(deductions) == 0 {
+		t.Fatalf("Expected a cell forcing chain.")
+	}
+	d := deductions[0]
+	if d.Technique != "Cell Forcing Chain" || d.Cells[0] != (Location{1, 1}) {
+		t.Errorf("Unexpected deduction: %s on %v.", d.Technique, d.Cells)
+	}
+	if !containsCandidate(d.Placements, Candidate{Location{3, 1}, 6}) {
+		t.Errorf("6 not placed at (3, 1): %v.", d.Placements)
+	}
+	if value, _ := b.GetValue(3, 1); value != 6 {
+		t.Errorf("Expected 6 at (3, 1), not %d.", value)
+	}
+	if value, _ := b.GetValue(1, 1); value != 1 {
+		t.Errorf("Expected 1 at (1, 1), the only candidate not leading to a contradiction, not %d.", value)
+	}
+}
+
+func TestFindUnitForcingChains(t *testing.T) {
+	b := forcingBoard(t)
+	deductions := b.FindUnitForcingChains()
+	if len(deductions) == 0 {
+		t.Fatalf("Expected a unit forcing chain.")
+	}
+	d := deductions[0]
+	if d.Technique != "Unit Forcing Chain" || d.Houses[0] != (House{RowHouse, 1}) {
+		t.Errorf("Unexpected deduction: %s in %v.", d.Technique, d.Houses)
+	}
+	if value, _ := b.GetValue(3, 1); value != 6 {
+		t.Errorf("Expected 6 at (3, 1), not %d.", value)
+	}
+}
+
+func TestFindNishio(t *testing.T) {
+	b := forcingBoard(t)
+	deductions := b.FindNishio()
+	if len(deductions) == 0 {
+		t.Fatalf("Expected nishio eliminations.")
+	}
+	for _, d := range deductions {
+		if d.Technique != "Nishio" || len(d.Eliminations) != 1 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+	}
+	if value, _ := b.GetValue(3, 1); value != 6 {
+		t.Errorf("Expected 6 at (3, 1), not %d.", value)
+	}
+	if value, _ := b.GetValue(1, 1); value != 1 {
+		t.Errorf("Expected 1 at (1, 1), 2 already being placed in column 1, not %d.", value)
+	}
+}
@@ -0,0 +1,188 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+)
+
+// Symmetry describes the pattern the givens of a generated puzzle must keep.
+type Symmetry int
+
+// The symmetries of generated puzzles.
+const (
+	NoSymmetry Symmetry = iota
+	Rotational          // unchanged when rotated 180 degrees
+	Diagonal            // unchanged when reflected in the main diagonal
+	Mirror              // unchanged when reflected left to right
+)
+
+// GeneratorOptions controls the puzzles made by Generate.
+type GeneratorOptions struct {
+	DimensionInBoxes int      // the size of the board, 3 if zero
+	Seed             int64    // seeds the random choices, the same seed giving the same puzzle
+	Givens           int      // stop removing givens once this many remain, 0 to remove all possible
+	Level            Level    // the level puzzles must rate, Unrated for any level
+	Symmetry         Symmetry // the pattern of the givens
+	Minimal          bool     // every given must be needed for the solution to be unique, overriding Givens
+	Attempts         int      // the number of puzzles tried to reach Level, 20 if zero
+}
+
+// image returns the cell a location is moved to by a symmetry of a board
+// holding maxValue rows.
+func (s Symmetry) image(l Location, maxValue int) Location {
+	switch s {
+	case Rotational:
+		return Location{maxValue + 1 - l.Column, maxValue + 1 - l.Row}
+	case Diagonal:
+		return Location{l.Row, l.Column}
+	case Mirror:
+		return Location{maxValue + 1 - l.Column, l.Row}
+	}
+	return l
+}
+
+// randomSolution fills the board with a random solution, searching depth
+// first as search does but trying the candidates of each cell in random
+// order.  False is returned if the board has no solution.
+func (b *Board) randomSolution(rng *rand.Rand) bool {
+	if !b.propagate() {
+		return false
+	}
+	column, row, found := b.fewestCandidates()
+	if !found {
+		return b.AllCellsDetermined()
+	}
+
+	cell, e := b.getCell(column, row)
+	if e != nil {
+		return false
+	}
+	candidates := cell.GetCandidates().GetAllMembers()
+	sort.Ints(candidates)
+	rng.Shuffle(len(candidates), func(i int, j int) {
+		candidates[i], candidates[j] = candidates[j], candidates[i]
+	})
+
+	state := b.snapshot()
+	for _, v := range candidates {
+		b.SetValue(column, row, v)
+		if b.randomSolution(rng) {
+			return true
+		}
+		b.restore(state)
+	}
+	return false
+}
+
+// uniqueGrid determines if the values of a grid, -1 for blank cells, have a
+// unique solution.
+func uniqueGrid(grid [][]int) bool {
+	b, e := NewBoardInitialize(grid)
+	return e == nil && b.HasUniqueSolution()
+}
+
+// symmetricGroups splits the cells of a board holding maxValue rows into the
+// groups a symmetry moves into each other: a cell and its image, or a cell on
+// the axis or centre alone.
+func symmetricGroups(maxValue int, symmetry Symmetry) [][]Location {
+	var rtnval [][]Location
+	done := make(map[Location]bool)
+	for row := 1; row <= maxValue; row++ {
+		for col := 1; col <= maxValue; col++ {
+			l := Location{col, row}
+			if done[l] {
+				continue
+			}
+			group := []Location{l}
+			if image := symmetry.image(l, maxValue); image != l {
+				group = append(group, image)
+			}
+			for _, m := range group {
+				done[m] = true
+			}
+			rtnval = append(rtnval, group)
+		}
+	}
+	return rtnval
+}
+
+// removeGivens blanks the cells of a solved grid in random order, keeping
+// each given whose removal would leave more than one solution, till only
+// target givens remain or no more can be removed.  A cell and its image under
+// the symmetry are removed or kept together.  Removing givens never makes a
+// kept group removable, so with no target no group of the result can be
+// removed.
+func removeGivens(grid [][]int, rng *rand.Rand, target int, symmetry Symmetry) {
+	groups := symmetricGroups(len(grid), symmetry)
+	rng.Shuffle(len(groups), func(i int, j int) {
+		groups[i], groups[j] = groups[j], groups[i]
+	})
+
+	givens := len(grid) * len(grid)
+	for _, group := range groups {
+		if givens-len(group) < target {
+			continue
+		}
+		values := make([]int, len(group))
+		for i, l := range group {
+			values[i] = grid[l.Row-1][l.Column-1]
+			grid[l.Row-1][l.Column-1] = -1
+		}
+		if uniqueGrid(grid) {
+			givens -= len(group)
+		} else {
+			for i, l := range group {
+				grid[l.Row-1][l.Column-1] = values[i]
+			}
+		}
+	}
+}
+
+// Generate creates a puzzle with a unique solution.  A random solution is
+// made, then givens are removed in random order, along with their images
+// under the requested symmetry, while the solution remains unique.  A minimal
+// puzzle has givens removed past any target till each one is needed; with a
+// symmetry, till no given can be removed along with its image, though one of
+// the pair alone might be.  Should a Level be requested, puzzles are made till
+// one matches, returning an error if none does within the allowed attempts.
+func Generate(options GeneratorOptions) (*Board, error) {
+	dimension, attempts := options.DimensionInBoxes, options.Attempts
+	if dimension == 0 {
+		dimension = 3
+	}
+	if attempts == 0 {
+		attempts = 20
+	}
+	rng := rand.New(rand.NewSource(options.Seed))
+
+	for attempt := 0; attempt < attempts; attempt++ {
+		solution, e := NewBoard(dimension, NewBox, NewCell)
+		if e != nil {
+			return nil, e
+		}
+		if !solution.randomSolution(rng) {
+			return nil, errors.New("Failed to fill board")
+		}
+		grid, e := solution.GetRepresentation()
+		if e != nil {
+			return nil, e
+		}
+		target := options.Givens
+		if options.Minimal {
+			target = 0
+		}
+		removeGivens(grid, rng, target, options.Symmetry)
+
+		b, e := NewBoardInitialize(grid)
+		if e != nil || options.Level == Unrated {
+			return b, e
+		}
+		if r, e := b.Rate(); e == nil && r.Level == options.Level {
+			return b, nil
+		}
+	}
+	msg := fmt.Sprintf("No matching puzzle found in %d attempts", attempts)
+	return nil, errors.New(msg)
+}
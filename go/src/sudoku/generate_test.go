@@ -0,0 +1,149 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// countGivens returns the number of determined cells of a board.
+func countGivens(b *Board) int {
+	rtnval := 0
+	for _, row := range b.snapshot() {
+		for _, s := range row {
+			if s.value >= 1 {
+				rtnval++
+			}
+		}
+	}
+	return rtnval
+}
+
+func TestGenerate(t *testing.T) {
+	b, e := Generate(GeneratorOptions{Seed: 1})
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if !b.HasUniqueSolution() {
+		t.Error("Generated puzzle does not have a unique solution.")
+	}
+	if givens := countGivens(b); givens < 17 || givens > 40 {
+		t.Errorf("Unexpected number of givens: %d", givens)
+	}
+	grid, _ := b.GetRepresentation()
+	again, e := Generate(GeneratorOptions{Seed: 1})
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	other, _ := again.GetRepresentation()
+	if !compare2dArrays(grid, other) {
+		t.Error("The same seed generated different puzzles.")
+	}
+	if !b.Solve() {
+		t.Error("Generated puzzle not solved.")
+	}
+}
+
+func TestGenerateGivens(t *testing.T) {
+	b, e := Generate(GeneratorOptions{Seed: 2, Givens: 40})
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if givens := countGivens(b); givens != 40 {
+		t.Errorf("Expected 40 givens, not %d.", givens)
+	}
+	if !b.HasUniqueSolution() {
+		t.Error("Generated puzzle does not have a unique solution.")
+	}
+}
+
+func TestGenerateLevel(t *testing.T) {
+	b, e := Generate(GeneratorOptions{Seed: 3, Givens: 36, Level: Easy})
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if r, _ := b.Rate(); r.Level != Easy {
+		t.Errorf("Expected an Easy puzzle, not %s.", r.Level)
+	}
+}
+
+func TestGenerateSymmetry(t *testing.T) {
+	for _, symmetry := range []Symmetry{Rotational, Diagonal, Mirror} {
+		b, e := Generate(GeneratorOptions{Seed: 4, Symmetry: symmetry})
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		grid, _ := b.GetRepresentation()
+		for row := range grid {
+			for col := range grid[row] {
+				image := symmetry.image(Location{col + 1, row + 1}, 9)
+				if (grid[row][col] == -1) != (grid[image.Row-1][image.Column-1] == -1) {
+					t.Errorf("Symmetry %d broken at (%d, %d).", symmetry, col+1, row+1)
+				}
+			}
+		}
+		if !b.HasUniqueSolution() {
+			t.Error("Generated puzzle does not have a unique solution.")
+		}
+	}
+}
+
+// removable returns a given of a grid which could be removed, along with its
+// image under the symmetry, with the solution staying unique.  False is
+// returned if every given is needed.
+func removable(grid [][]int, symmetry Symmetry) (Location, bool) {
+	for _, group := range symmetricGroups(len(grid), symmetry) {
+		l := group[0]
+		if grid[l.Row-1][l.Column-1] < 1 {
+			continue
+		}
+		values := make([]int, len(group))
+		for i, m := range group {
+			values[i] = grid[m.Row-1][m.Column-1]
+			grid[m.Row-1][m.Column-1] = -1
+		}
+		unique := uniqueGrid(grid)
+		for i, m := range group {
+			grid[m.Row-1][m.Column-1] = values[i]
+		}
+		if unique {
+			return l, true
+		}
+	}
+	return Location{}, false
+}
+
+func TestGenerateMinimal(t *testing.T) {
+	for _, symmetry := range []Symmetry{NoSymmetry, Rotational, Diagonal, Mirror} {
+		for seed := int64(5); seed < 8; seed++ {
+			b, e := Generate(GeneratorOptions{Seed: seed, Symmetry: symmetry, Minimal: true})
+			if e != nil {
+				t.Fatal(e.Error())
+			}
+			grid, _ := b.GetRepresentation()
+			if l, found := removable(grid, symmetry); found {
+				t.Errorf("Symmetry %d seed %d: given at %s not needed.", symmetry, seed, l)
+			}
+			for row := range grid {
+				for col := range grid[row] {
+					image := symmetry.image(Location{col + 1, row + 1}, 9)
+					if (grid[row][col] == -1) != (grid[image.Row-1][image.Column-1] == -1) {
+						t.Errorf("Symmetry %d broken at (%d, %d).", symmetry, col+1, row+1)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateMinimalIgnoresGivens(t *testing.T) {
+	b, e := Generate(GeneratorOptions{Seed: 5, Givens: 30, Minimal: true})
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	grid, _ := b.GetRepresentation()
+	if l, found := removable(grid, NoSymmetry); found {
+		t.Errorf("Given at %s not needed.", l)
+	}
+	if givens := countGivens(b); givens >= 30 {
+		t.Errorf("Expected fewer than 30 givens, not %d.", givens)
+	}
+}
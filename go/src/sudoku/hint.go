@@ -0,0 +1,117 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Hint describes the easiest deduction available on a board, without it
+// having been applied.  Nudge, Technique and Explanation give progressively
+// more away: the house or cell to look at, the technique to use, then the
+// values it places or candidates it eliminates.
+type Hint struct {
+	Deduction
+	Targets     []Location
+	Nudge       string
+	Explanation string
+}
+
+// listOf joins descriptions as "a", "a and b" or "a, b and c".
+func listOf(items []string) string {
+	if len(items) <= 1 {
+		return strings.Join(items, "")
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+}
+
+// explain describes a deduction in words, writing values with the symbols of
+// an alphabet, such as "Naked Pair of 3 and 7 in row 5 eliminates 3 from
+// (4, 5) and 7 from (6, 5)."
+func explain(d Deduction, a Alphabet) string {
+	var buffer strings.Builder
+	buffer.WriteString(d.Technique)
+	if len(d.Digits) > 0 {
+		var digits []string
+		for _, v := range d.Digits {
+			digits = append(digits, a.Symbol(v))
+		}
+		buffer.WriteString(" of " + listOf(digits))
+	}
+	if len(d.Houses) > 0 {
+		var houses []string
+		for _, h := range d.Houses {
+			houses = append(houses, h.String())
+		}
+		buffer.WriteString(" in " + listOf(houses))
+	}
+
+	var actions []string
+	var placements []string
+	for _, c := range d.Placements {
+		placements = append(placements, fmt.Sprintf("%s at %s", a.Symbol(c.Value), c.Location))
+	}
+	if len(placements) > 0 {
+		actions = append(actions, "places "+listOf(placements))
+	}
+	var eliminations []string
+	for v := 1; ; v++ {
+		var cells []string
+		remaining := false
+		for _, c := range d.Eliminations {
+			if c.Value == v {
+				cells = append(cells, c.Location.String())
+			}
+			remaining = remaining || c.Value > v
+		}
+		if len(cells) > 0 {
+			eliminations = append(eliminations, fmt.Sprintf("%s from %s", a.Symbol(v), listOf(cells)))
+		}
+		if !remaining {
+			break
+		}
+	}
+	if len(eliminations) > 0 {
+		actions = append(actions, "eliminates "+listOf(eliminations))
+	}
+	if len(actions) > 0 {
+		buffer.WriteString(" " + strings.Join(actions, " and "))
+	}
+	buffer.WriteString(".")
+	return buffer.String()
+}
+
+// newHint describes a deduction as a hint, writing values with the symbols of
+// an alphabet.  The nudge points at the first house of the pattern, or else
+// the first target.
+func newHint(d Deduction, a Alphabet) *Hint {
+	h := &Hint{Deduction: d, Explanation: explain(d, a)}
+	for _, c := range append(append([]Candidate{}, d.Placements...), d.Eliminations...) {
+		if !containsLocation(h.Targets, c.Location) {
+			h.Targets = append(h.Targets, c.Location)
+		}
+	}
+	if len(d.Houses) > 0 {
+		h.Nudge = "Look at " + d.Houses[0].String() + "."
+	} else if len(h.Targets) > 0 {
+		h.Nudge = "Look at cell " + h.Targets[0].String() + "."
+	}
+	return h
+}
+
+// Hint finds the easiest deduction available on the board, trying the board's
+// strategies in the order SinglePassSolve does on a copy of the board, so the
+// board itself is left unchanged.  An error is returned if no strategy makes
+// progress.
+func (b *Board) Hint() (*Hint, error) {
+	clone, e := b.Clone()
+	if e != nil {
+		return nil, e
+	}
+	for _, s := range clone.Strategies().Strategies() {
+		if deductions := s.Apply(clone); len(deductions) > 0 {
+			return newHint(deductions[0], b.Alphabet()), nil
+		}
+	}
+	return nil, errors.New("No deduction found")
+}
@@ -0,0 +1,82 @@
+package sudoku
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestHint(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		before, _ := b.GetRepresentation()
+		h, e := b.Hint()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		after, _ := b.GetRepresentation()
+		if !compare2dArrays(before, after) {
+			t.Error("Hint changed the board.")
+		}
+		if h.Technique != "Naked Single" || len(h.Placements) != 1 || len(h.Targets) != 1 {
+			t.Fatalf("Unexpected hint: %s placing %v", h.Technique, h.Placements)
+		}
+		c := h.Placements[0]
+		if solutionBoard1[c.Row-1][c.Column-1] != c.Value {
+			t.Errorf("Hint placed %s.", c)
+		}
+		if h.Nudge != fmt.Sprintf("Look at row %d.", c.Row) {
+			t.Errorf("Unexpected nudge: %s", h.Nudge)
+		}
+		if h.Explanation == "" {
+			t.Error("Hint not explained.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestHintExplanation(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 4, []int{3, 8})
+		restrictValue(b, 7, 4, []int{3, 8})
+		b.SetStrategies(NewRegistry(NewStrategy("X-Wing", 3.2, func(b *Board) []Deduction { return b.FindXWing(false) })))
+		h, e := b.Hint()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if h.Nudge != "Look at row 2." {
+			t.Errorf("Unexpected nudge: %s", h.Nudge)
+		}
+		expected := "X-Wing of 4 in row 2 and row 7 eliminates 4 from (3, 1), (3, 3), (3, 4), (3, 5), (3, 6), (3, 8), (3, 9), (8, 1), (8, 3), (8, 4), (8, 5), (8, 6), (8, 8) and (8, 9)."
+		if h.Explanation != expected {
+			t.Errorf("Unexpected explanation: %s", h.Explanation)
+		}
+		if len(h.Targets) != 14 {
+			t.Errorf("Expected 14 targets, not %d.", len(h.Targets))
+		}
+		if !b.hasCandidate(Location{3, 1}, 4) {
+			t.Error("Hint eliminated a candidate from the board.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestHintNone(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		r := DefaultRegistry()
+		for _, s := range r.Strategies() {
+			if s.Difficulty() > 3.0 {
+				r.Disable(s.Name())
+			}
+		}
+		b.SetStrategies(r)
+		if _, e := b.Hint(); e == nil {
+			t.Error("Hint found on an empty board.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -0,0 +1,146 @@
+package sudoku
+
+import (
+	"fmt"
+)
+
+// HouseKind identifies whether a house is a row, column or box.
+type HouseKind int
+
+// The kinds of houses found on a board.
+const (
+	RowHouse HouseKind = iota
+	ColumnHouse
+	BoxHouse
+)
+
+// House is a row, column or box of the board.  Each house must hold every
+// value exactly once.  Index is the row, column or box number starting at 1,
+// boxes being numbered left to right, top to bottom.
+type House struct {
+	Kind  HouseKind
+	Index int
+}
+
+// String describes the house, such as "row 5".
+func (h House) String() string {
+	switch h.Kind {
+	case RowHouse:
+		return fmt.Sprintf("row %d", h.Index)
+	case ColumnHouse:
+		return fmt.Sprintf("column %d", h.Index)
+	case BoxHouse:
+		return fmt.Sprintf("box %d", h.Index)
+	}
+	return fmt.Sprintf("unknown house %d", h.Index)
+}
+
+// Location identifies a cell of the board by its column and row.
+type Location struct {
+	Column int
+	Row    int
+}
+
+// String describes the location as (column, row).
+func (l Location) String() string {
+	return fmt.Sprintf("(%d, %d)", l.Column, l.Row)
+}
+
+// houses returns every row, column and box of the board.
+func (b *Board) houses() []House {
+	rtnval := make([]House, 0, 3*b.maxValue)
+	for _, kind := range []HouseKind{RowHouse, ColumnHouse, BoxHouse} {
+		for index := 1; index <= b.maxValue; index++ {
+			rtnval = append(rtnval, House{kind, index})
+		}
+	}
+	return rtnval
+}
+
+// houseLocations returns the location of every cell in a house.  Box cells are
+// ordered left to right, top to bottom.
+func (b *Board) houseLocations(h House) []Location {
+	rtnval := make([]Location, 0, b.maxValue)
+	switch h.Kind {
+	case RowHouse:
+		for column := 1; column <= b.maxValue; column++ {
+			rtnval = append(rtnval, Location{column, h.Index})
+		}
+	case ColumnHouse:
+		for row := 1; row <= b.maxValue; row++ {
+			rtnval = append(rtnval, Location{h.Index, row})
+		}
+	case BoxHouse:
+		firstColumn := 1 + b.boxWidth*((h.Index-1)%b.boxHeight)
+		firstRow := 1 + b.boxHeight*((h.Index-1)/b.boxHeight)
+		for row := firstRow; row < firstRow+b.boxHeight; row++ {
+			for column := firstColumn; column < firstColumn+b.boxWidth; column++ {
+				rtnval = append(rtnval, Location{column, row})
+			}
+		}
+	}
+	return rtnval
+}
+
+// boxOf returns the number of the box holding a location.
+func (b *Board) boxOf(l Location) int {
+	boxNum, _, _ := b.columnRowToBoxNum(l.Column, l.Row)
+	return boxNum
+}
+
+// valuePlaced determines if a value has already been placed in a house.
+func (b *Board) valuePlaced(h House, value int) bool {
+	for _, l := range b.houseLocations(h) {
+		v, e := b.GetValue(l.Column, l.Row)
+		if e == nil && v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// combinations calls fn with every way of choosing size indices out of
+// 0..n-1, each in increasing order.  Enumeration stops early if fn returns
+// false.
+func combinations(n int, size int, fn func(indices []int) bool) {
+	if size < 1 || size > n {
+		return
+	}
+	indices := make([]int, size)
+	for i := range indices {
+		indices[i] = i
+	}
+	for {
+		if !fn(indices) {
+			return
+		}
+		// Advance to the next combination.
+		i := size - 1
+		for i >= 0 && indices[i] == n-size+i {
+			i--
+		}
+		if i < 0 {
+			return
+		}
+		indices[i]++
+		for j := i + 1; j < size; j++ {
+			indices[j] = indices[j-1] + 1
+		}
+	}
+}
+
+// growSets calls fn with each set of at most max of the cells, built up a cell
+// at a time in the order the cells are given.  Should fn return false, no
+// larger set holding the cells it was passed is built.
+func growSets(cells []Location, max int, fn func(chosen []Location) bool) {
+	var grow func(start int, chosen []Location)
+	grow = func(start int, chosen []Location) {
+		for i := start; i < len(cells) && len(chosen) < max; i++ {
+			next := append(chosen[:len(chosen):len(chosen)], cells[i])
+			if fn(next) {
+				grow(i+1, next)
+			}
+		}
+	}
+	grow(0, nil)
+}
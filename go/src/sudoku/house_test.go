@@ -0,0 +1,80 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestHouseLocations(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		locations := b.houseLocations(House{BoxHouse, 6})
+		expected := []Location{{7, 4}, {8, 4}, {9, 4}, {7, 5}, {8, 5}, {9, 5}, {7, 6}, {8, 6}, {9, 6}}
+		if len(locations) != len(expected) {
+			t.Fatalf("Expected %d locations in box 6, not %d.", len(expected), len(locations))
+		}
+		for i, l := range locations {
+			if l != expected[i] {
+				t.Errorf("Expected %s in box 6, instead %s.", expected[i], l)
+			}
+			if b.boxOf(l) != 6 {
+				t.Errorf("Location %s reported in box %d, not box 6.", l, b.boxOf(l))
+			}
+		}
+
+		row := b.houseLocations(House{RowHouse, 4})
+		if len(row) != 9 || row[0] != (Location{1, 4}) || row[8] != (Location{9, 4}) {
+			t.Errorf("Unexpected locations for row 4: %v", row)
+		}
+		column := b.houseLocations(House{ColumnHouse, 2})
+		if len(column) != 9 || column[0] != (Location{2, 1}) || column[8] != (Location{2, 9}) {
+			t.Errorf("Unexpected locations for column 2: %v", column)
+		}
+		if len(b.houses()) != 27 {
+			t.Errorf("Expected 27 houses, not %d.", len(b.houses()))
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestHouseString(t *testing.T) {
+	if (House{RowHouse, 5}).String() != "row 5" {
+		t.Errorf("Unexpected description of row 5: %s", House{RowHouse, 5})
+	}
+	if (House{ColumnHouse, 2}).String() != "column 2" {
+		t.Errorf("Unexpected description of column 2: %s", House{ColumnHouse, 2})
+	}
+	if (House{BoxHouse, 9}).String() != "box 9" {
+		t.Errorf("Unexpected description of box 9: %s", House{BoxHouse, 9})
+	}
+}
+
+func TestCombinations(t *testing.T) {
+	count := 0
+	combinations(9, 3, func(indices []int) bool {
+		count++
+		for i := 1; i < len(indices); i++ {
+			if indices[i-1] >= indices[i] {
+				t.Errorf("Combination not in increasing order: %v", indices)
+			}
+		}
+		return true
+	})
+	if count != 84 {
+		t.Errorf("Expected 84 combinations of 3 out of 9, not %d.", count)
+	}
+
+	count = 0
+	combinations(5, 2, func(indices []int) bool {
+		count++
+		return count < 4
+	})
+	if count != 4 {
+		t.Errorf("Enumeration expected to stop after 4 combinations, not %d.", count)
+	}
+
+	combinations(2, 3, func(indices []int) bool {
+		t.Errorf("No combinations of 3 out of 2 expected.")
+		return true
+	})
+}
@@ -0,0 +1,88 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// NewBoardFromLine creates a board from the single line format of puzzle
+// collections, holding the cells row by row as symbols of the alphabet, with
+// '.' or '0' for blank cells.  A line of 81 symbols makes a 9x9 board, 256 a
+// 16x16 board and 625 a 25x25 board.  '0' only stands for a blank cell if the
+// alphabet does not use it, as HexAlphabet does.  The board keeps the alphabet
+// to write its values with.  The shape of the boxes is found from the number
+// of rows by boxShape, so other shapes, such as boxes taller than they are
+// wide, need NewRectangularBoardFromLine.
+func NewBoardFromLine(line string, a Alphabet) (*Board, error) {
+	_, numRows, e := lineSymbols(line)
+	if e != nil {
+		return nil, e
+	}
+	boxWidth, boxHeight, ok := boxShape(numRows)
+	if !ok {
+		msg := fmt.Sprintf("No box shape for row count(%d)!", numRows)
+		return nil, errors.New(msg)
+	}
+	return NewRectangularBoardFromLine(boxWidth, boxHeight, line, a)
+}
+
+// NewRectangularBoardFromLine creates a board from the single line format, as
+// NewBoardFromLine does, whose boxes are boxWidth cells wide and boxHeight
+// cells tall.
+func NewRectangularBoardFromLine(boxWidth int, boxHeight int, line string, a Alphabet) (*Board, error) {
+	symbols, numRows, e := lineSymbols(line)
+	if e != nil {
+		return nil, e
+	}
+	if numRows != boxWidth*boxHeight {
+		msg := fmt.Sprintf("Row count(%d) does not match boxes of %dx%d!", numRows, boxWidth, boxHeight)
+		return nil, errors.New(msg)
+	}
+	b, e := NewRectangularBoard(boxWidth, boxHeight, NewRectangularBox, NewCell)
+	if e != nil {
+		return nil, e
+	}
+	if e := b.SetAlphabet(a); e != nil {
+		return nil, e
+	}
+
+	for i, s := range symbols {
+		value, e := a.Value(s)
+		if e != nil || value > numRows {
+			if s == '.' || s == '0' {
+				continue
+			}
+			msg := fmt.Sprintf("Invalid symbol %q at position %d!", s, i+1)
+			return nil, errors.New(msg)
+		}
+		b.SetValue(i%numRows+1, i/numRows+1, value)
+	}
+	return b, nil
+}
+
+// lineSymbols returns the symbols of a line in the single line format along
+// with the number of rows they make.  An error is returned if they do not
+// make a square board.
+func lineSymbols(line string) ([]rune, int, error) {
+	symbols := []rune(strings.TrimSpace(line))
+	numRows, _ := IntSquareRoot(len(symbols))
+	if numRows*numRows != len(symbols) {
+		msg := fmt.Sprintf("Line of %d symbols does not make a square board!", len(symbols))
+		return nil, 0, errors.New(msg)
+	}
+	return symbols, numRows, nil
+}
+
+// Line returns the board in the single line format read by NewBoardFromLine,
+// writing values with the board's alphabet and '.' for undetermined cells.
+func (b *Board) Line() string {
+	var buffer strings.Builder
+	for row := 1; row <= b.maxValue; row++ {
+		for col := 1; col <= b.maxValue; col++ {
+			v, _ := b.GetValue(col, row)
+			buffer.WriteString(b.Alphabet().Symbol(v))
+		}
+	}
+	return buffer.String()
+}
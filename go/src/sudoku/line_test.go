@@ -0,0 +1,123 @@
+package sudoku
+
+import (
+	"strings"
+	"testing"
+)
+
+const solvableLine1 = "...26.7.168..7..9.19...45..82.1...4...46.29...5...3.28..93...74.4..5..367.3.18..."
+
+func TestNewBoardFromLine(t *testing.T) {
+	b, e := NewBoardFromLine(solvableLine1, DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	board, _ := b.GetRepresentation()
+	if !compare2dArrays(board, solvableBoard1) {
+		t.Error("Line not read as solvableBoard1.")
+	}
+	if b.Line() != solvableLine1 {
+		t.Errorf("Unexpected line: %s", b.Line())
+	}
+
+	b, e = NewBoardFromLine(" "+strings.Replace(solvableLine1, ".", "0", -1)+"\n", DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if b.Line() != solvableLine1 {
+		t.Errorf("Blanks written as 0 not read: %s", b.Line())
+	}
+	if !b.Solve() {
+		t.Fatal("Failed to solve board read from line.")
+	}
+	board, _ = b.GetRepresentation()
+	if !compare2dArrays(board, solutionBoard1) {
+		t.Error("Board read from line solved incorrectly.")
+	}
+}
+
+func TestLineSizes(t *testing.T) {
+	for _, test := range []struct {
+		width, height int
+		alphabet      Alphabet
+	}{
+		{3, 2, DigitAlphabet}, {4, 4, DigitAlphabet}, {4, 4, HexAlphabet}, {5, 5, LetterAlphabet},
+	} {
+		size := test.width * test.height
+		var buffer strings.Builder
+		for r, row := range patternSolution(test.width, test.height) {
+			for c, v := range row {
+				if (r+c)%3 == 0 {
+					buffer.WriteString(".")
+				} else {
+					buffer.WriteString(test.alphabet.Symbol(v))
+				}
+			}
+		}
+		line := buffer.String()
+		b, e := NewBoardFromLine(line, test.alphabet)
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if b.maxValue != size || b.Line() != line {
+			t.Errorf("Line of size %d not read back: %s", size, b.Line())
+		}
+		lower, e := NewBoardFromLine(strings.ToLower(line), test.alphabet)
+		if e != nil || lower.Line() != line {
+			t.Errorf("Lower case line of size %d not read.", size)
+		}
+		if !b.Solve() {
+			t.Errorf("Failed to solve line of size %d.", size)
+		}
+		if strings.Contains(b.Line(), ".") {
+			t.Errorf("Blank cell written after solving: %s", b.Line())
+		}
+	}
+}
+
+func TestNewRectangularBoardFromLine(t *testing.T) {
+	var buffer strings.Builder
+	for _, row := range patternSolution(2, 3) {
+		for _, v := range row {
+			buffer.WriteString(DigitAlphabet.Symbol(v))
+		}
+	}
+	line := buffer.String()
+	b, e := NewRectangularBoardFromLine(2, 3, line, DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if b.boxWidth != 2 || b.boxHeight != 3 || b.Line() != line {
+		t.Errorf("Line not read with 2x3 boxes: %s", b.Line())
+	}
+	if ok, e := b.IsValid(); !ok {
+		t.Error(e.Error())
+	}
+	if _, e := NewRectangularBoardFromLine(3, 3, line, DigitAlphabet); e == nil {
+		t.Error("Line of 6 rows read with 3x3 boxes.")
+	}
+}
+
+func TestNewBoardFromLineErrors(t *testing.T) {
+	for _, line := range []string{
+		"",
+		solvableLine1[1:],
+		solvableLine1[:80] + "A",
+		solvableLine1[:80] + "x",
+		strings.Repeat(".", 64),
+	} {
+		if _, e := NewBoardFromLine(line, DigitAlphabet); e == nil {
+			t.Errorf("Line accepted: %q", line)
+		}
+	}
+	if _, e := NewBoardFromLine(strings.Repeat(".", 256), DigitAlphabet[:9]); e == nil {
+		t.Error("Alphabet too short for the board accepted.")
+	}
+	b, e := NewBoardFromLine("0"+strings.Repeat(".", 255), HexAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if v, _ := b.GetValue(1, 1); v != 1 {
+		t.Errorf("Expected 0 to stand for 1 in the hex alphabet, not %d.", v)
+	}
+}
@@ -0,0 +1,103 @@
+package sudoku
+
+// candidateLocations returns the locations within a house of the undetermined
+// cells still holding value as a candidate.
+func (b *Board) candidateLocations(house House, value int) []Location {
+	var rtnval []Location
+	for _, l := range b.houseLocations(house) {
+		if b.hasCandidate(l, value) {
+			rtnval = append(rtnval, l)
+		}
+	}
+	return rtnval
+}
+
+// lockedCandidates eliminates value from the cells of target which are not
+// part of source, given every candidate for value in source lies within target.
+func (b *Board) lockedCandidates(technique string, source House, target House, value int, cells []Location) []Deduction {
+	d := Deduction{Technique: technique, Cells: cells, Houses: []House{source, target}, Digits: []int{value}}
+	for _, l := range b.houseLocations(target) {
+		if !b.inHouse(l, source) && b.hasCandidate(l, value) {
+			d.Eliminations = append(d.Eliminations, Candidate{l, value})
+		}
+	}
+	if len(d.Eliminations) > 0 {
+		b.eliminate(d.Eliminations)
+		return []Deduction{d}
+	}
+	return nil
+}
+
+// inHouse determines if a location is one of the cells of a house.
+func (b *Board) inHouse(l Location, house House) bool {
+	switch house.Kind {
+	case RowHouse:
+		return l.Row == house.Index
+	case ColumnHouse:
+		return l.Column == house.Index
+	case BoxHouse:
+		return b.boxOf(l) == house.Index
+	}
+	return false
+}
+
+// FindPointing looks for a value whose candidates within a box all lie in the
+// same row or column.  The value must be placed in that box, so it is
+// eliminated from the rest of the row or column.
+func (b *Board) FindPointing() []Deduction {
+	var rtnval []Deduction
+	for boxNum := 1; boxNum <= b.maxValue; boxNum++ {
+		box := House{BoxHouse, boxNum}
+		for v := 1; v <= b.maxValue; v++ {
+			cells := b.candidateLocations(box, v)
+			if len(cells) < 2 || b.valuePlaced(box, v) {
+				continue
+			}
+			sameRow, sameColumn := true, true
+			for _, l := range cells[1:] {
+				sameRow = sameRow && l.Row == cells[0].Row
+				sameColumn = sameColumn && l.Column == cells[0].Column
+			}
+			if sameRow {
+				rtnval = append(rtnval, b.lockedCandidates("Pointing", box, House{RowHouse, cells[0].Row}, v, cells)...)
+			}
+			if sameColumn {
+				rtnval = append(rtnval, b.lockedCandidates("Pointing", box, House{ColumnHouse, cells[0].Column}, v, cells)...)
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindClaiming looks for a value whose candidates within a row or column all
+// lie in the same box.  The value must be placed in that row or column, so it
+// is eliminated from the rest of the box.  This is also known as box/line
+// reduction.
+func (b *Board) FindClaiming() []Deduction {
+	var rtnval []Deduction
+	for _, kind := range []HouseKind{RowHouse, ColumnHouse} {
+		for index := 1; index <= b.maxValue; index++ {
+			line := House{kind, index}
+			for v := 1; v <= b.maxValue; v++ {
+				cells := b.candidateLocations(line, v)
+				if len(cells) < 2 || b.valuePlaced(line, v) {
+					continue
+				}
+				boxNum := b.boxOf(cells[0])
+				sameBox := true
+				for _, l := range cells[1:] {
+					sameBox = sameBox && b.boxOf(l) == boxNum
+				}
+				if sameBox {
+					rtnval = append(rtnval, b.lockedCandidates("Claiming", line, House{BoxHouse, boxNum}, v, cells)...)
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindLockedCandidates applies both pointing and claiming eliminations.
+func (b *Board) FindLockedCandidates() []Deduction {
+	return append(b.FindPointing(), b.FindClaiming()...)
+}
@@ -0,0 +1,67 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestFindPointing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Within box 1, 5 can only be placed in row 2.
+		for _, l := range []Location{{1, 1}, {2, 1}, {3, 1}, {3, 2}, {1, 3}, {2, 3}, {3, 3}} {
+			b.SetCandidates(l.Column, l.Row, []int{1, 2, 3, 4, 6, 7, 8, 9})
+		}
+		deductions := b.FindPointing()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 pointing deduction, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Houses[0] != (House{BoxHouse, 1}) || d.Houses[1] != (House{RowHouse, 2}) {
+			t.Errorf("Unexpected houses for pointing deduction: %v", d.Houses)
+		}
+		if len(d.Eliminations) != 6 {
+			t.Errorf("Expected 6 eliminations, not %d.", len(d.Eliminations))
+		}
+		for col := 4; col <= 9; col++ {
+			if b.hasCandidate(Location{col, 2}, 5) {
+				t.Errorf("5 not eliminated from (%d, 2).", col)
+			}
+		}
+		if !b.hasCandidate(Location{1, 2}, 5) || !b.hasCandidate(Location{4, 3}, 5) {
+			t.Errorf("5 eliminated from a cell outside of row 2 or within box 1.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindClaiming(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Within row 7, 3 can only be placed in box 9.
+		for col := 1; col <= 6; col++ {
+			b.SetCandidates(col, 7, []int{1, 2, 4, 5, 6, 7, 8, 9})
+		}
+		deductions := b.FindClaiming()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 claiming deduction, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Claiming" || d.Houses[1] != (House{BoxHouse, 9}) {
+			t.Errorf("Unexpected claiming deduction: %s in %v", d.Technique, d.Houses)
+		}
+		for _, l := range b.houseLocations(House{BoxHouse, 9}) {
+			if l.Row != 7 && b.hasCandidate(l, 3) {
+				t.Errorf("3 not eliminated from %s.", l)
+			}
+		}
+		if !b.hasCandidate(Location{8, 7}, 3) {
+			t.Errorf("3 eliminated from within row 7.")
+		}
+		if len(b.FindLockedCandidates()) != 0 {
+			t.Errorf("Locked candidates found once nothing is left to eliminate.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -23,3 +23,21 @@ func IsPerfectSquare(value int) bool {
 	}
 	return (intRoot * intRoot) == value
 }
+
+// boxShape returns the width and height in cells of the boxes of a board
+// holding maxValue values.  Boxes are square when maxValue is a perfect
+// square, otherwise one cell wider than they are tall, as the 3x2 boxes of a
+// 6x6 board.  Boards with other boxes, such as 2x3 or 4x2, must be given their
+// box shape.  False is returned if neither shape holds maxValue cells.
+func boxShape(maxValue int) (int, int, bool) {
+	height, err := IntSquareRoot(maxValue)
+	if err != nil || height < 2 {
+		return 0, 0, false
+	}
+	for _, width := range []int{height, height + 1} {
+		if width*height == maxValue {
+			return width, height, true
+		}
+	}
+	return 0, 0, false
+}
@@ -40,3 +40,17 @@ func TestIntSquareRoot(t *testing.T) {
 	checkRoot(t, 7, 2)
 	checkRoot(t, -1, 0)
 }
+
+func TestBoxShape(t *testing.T) {
+	for _, test := range []struct{ maxValue, width, height int }{{4, 2, 2}, {6, 3, 2}, {9, 3, 3}, {12, 4, 3}, {16, 4, 4}, {20, 5, 4}, {25, 5, 5}} {
+		width, height, ok := boxShape(test.maxValue)
+		if !ok || width != test.width || height != test.height {
+			t.Errorf("Expected %dx%d boxes for %d, not %dx%d.", test.width, test.height, test.maxValue, width, height)
+		}
+	}
+	for _, maxValue := range []int{-1, 0, 1, 2, 3, 5, 8, 10, 18} {
+		if _, _, ok := boxShape(maxValue); ok {
+			t.Errorf("Box shape found for %d.", maxValue)
+		}
+	}
+}
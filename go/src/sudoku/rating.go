@@ -0,0 +1,143 @@
+package sudoku
+
+import (
+	"errors"
+	"sort"
+)
+
+// Level buckets the difficulty of a puzzle.
+type Level int
+
+// The levels of difficulty, from puzzles needing only singles to those
+// needing chains or worse.  TrialAndError puzzles need a technique assuming
+// candidates and following their consequences, whatever its difficulty.
+// Unrated puzzles can not be solved without guessing.
+const (
+	Unrated Level = iota
+	Easy
+	Medium
+	Hard
+	Expert
+	Extreme
+	TrialAndError
+)
+
+// levelLimits holds the hardest technique difficulty of each level but
+// Extreme.
+var levelLimits = []struct {
+	level      Level
+	difficulty float64
+}{
+	{Easy, 1.5},
+	{Medium, 3.0},
+	{Hard, 4.5},
+	{Expert, 6.0},
+}
+
+// String names the level, such as "Hard".
+func (l Level) String() string {
+	switch l {
+	case Unrated:
+		return "Unrated"
+	case Easy:
+		return "Easy"
+	case Medium:
+		return "Medium"
+	case Hard:
+		return "Hard"
+	case Expert:
+		return "Expert"
+	case Extreme:
+		return "Extreme"
+	case TrialAndError:
+		return "Trial and Error"
+	}
+	return "Unknown"
+}
+
+// trialTechniques names the techniques assuming candidates and following their
+// consequences on copies of the board, which is trial and error rather than a
+// pattern to be spotted.
+var trialTechniques = map[string]bool{
+	"Cell Forcing Chain": true,
+	"Unit Forcing Chain": true,
+	"Nishio":             true,
+}
+
+// levelOf returns the level of a puzzle whose hardest technique has the
+// given difficulty.
+func levelOf(difficulty float64) Level {
+	for _, limit := range levelLimits {
+		if difficulty <= limit.difficulty {
+			return limit.level
+		}
+	}
+	return Extreme
+}
+
+// Rating describes how hard a puzzle is to solve by logic alone.  Score is the
+// difficulty of the hardest technique needed, named by Hardest.  Should the
+// puzzle need trial and error, such as forcing chains or nishio, it is rated
+// TrialAndError whatever its Score.  Should the puzzle need guessing, Guessing
+// is set and the puzzle is left Unrated with a zero Score.  Steps holds the
+// deductions made, easiest first at every step.
+type Rating struct {
+	Score    float64
+	Hardest  string
+	Level    Level
+	Guessing bool
+	Steps    []Deduction
+}
+
+// Rate solves a copy of the board using only its enabled strategies, always
+// applying the easiest one making progress whatever order they are held in,
+// and rates the puzzle by the hardest needed.  The uniqueness techniques are
+// used if the puzzle has a unique solution.  An error is returned if the
+// puzzle has no solution.
+func (b *Board) Rate() (Rating, error) {
+	var rtnval Rating
+	if b.CountSolutions(1) == 0 {
+		return rtnval, errors.New("Puzzle has no solution")
+	}
+	clone, e := b.Clone()
+	if e != nil {
+		return rtnval, e
+	}
+	clone.AssumeUniqueSolution(b.HasUniqueSolution())
+	strategies := b.Strategies().Strategies()
+	sort.SliceStable(strategies, func(i, j int) bool {
+		return strategies[i].Difficulty() < strategies[j].Difficulty()
+	})
+
+	trial := false
+	for progress := true; progress && !clone.AllCellsDetermined(); {
+		progress = false
+		clone.startRecording()
+		for _, s := range strategies {
+			if deductions := s.Apply(clone); len(deductions) > 0 {
+				clone.record(deductions)
+				for _, d := range deductions {
+					trial = trial || trialTechniques[d.Technique]
+				}
+				if s.Difficulty() > rtnval.Score {
+					rtnval.Score, rtnval.Hardest = s.Difficulty(), s.Name()
+				}
+				progress = true
+				break
+			}
+		}
+		if !progress {
+			clone.record(nil)
+		}
+	}
+	rtnval.Steps = clone.Steps()
+	if !clone.AllCellsDetermined() {
+		rtnval.Score, rtnval.Hardest, rtnval.Guessing = 0, "", true
+		return rtnval, nil
+	}
+	rtnval.Level = levelOf(rtnval.Score)
+	if trial {
+		rtnval.Level = TrialAndError
+	}
+	return rtnval, nil
+}
@@ -0,0 +1,110 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestRate(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		r, e := b.Rate()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if r.Guessing || r.Level == Unrated || r.Score == 0 || r.Hardest == "" {
+			t.Errorf("Unexpected rating: %+v", r)
+		}
+		if r.Level != levelOf(r.Score) {
+			t.Errorf("Score %.1f rated %s.", r.Score, r.Level)
+		}
+		if b.AllCellsDetermined() || len(b.Steps()) != 0 {
+			t.Error("Rating changed the board.")
+		}
+		if len(r.Steps) == 0 {
+			t.Error("No steps recorded.")
+		}
+		if r.Level != Easy {
+			t.Errorf("Expected an Easy puzzle, not %s.", r.Level)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestRateDifficult(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		r, e := b.Rate()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if r.Guessing || r.Level != Hard || r.Hardest != "XY-Wing" || r.Score != 4.2 {
+			t.Errorf("Unexpected rating: %s %.1f needing %s", r.Level, r.Score, r.Hardest)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestRateGuessing(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		b.SetStrategies(NewRegistry(DefaultRegistry().Strategies()[0]))
+		r, e := b.Rate()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if !r.Guessing || r.Level != Unrated || r.Score != 0 {
+			t.Errorf("Unexpected rating: %+v", r)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestRateNoSolution(t *testing.T) {
+	b, e := NewBoardInitialize(noSolutionBoard)
+	if e == nil {
+		if _, e := b.Rate(); e == nil {
+			t.Error("Puzzle without a solution rated.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestLevelOf(t *testing.T) {
+	for _, test := range []struct {
+		difficulty float64
+		level      Level
+	}{{1.2, Easy}, {1.5, Easy}, {2.6, Medium}, {3.2, Hard}, {5.6, Expert}, {7.0, Extreme}} {
+		if l := levelOf(test.difficulty); l != test.level {
+			t.Errorf("Difficulty %.1f rated %s, not %s.", test.difficulty, l, test.level)
+		}
+	}
+}
+
+func TestRateTrialAndError(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		defaults := DefaultRegistry()
+		r := NewRegistry()
+		for _, name := range []string{"Naked Single", "Hidden Single", "Forcing Chains"} {
+			for _, s := range defaults.Strategies() {
+				if s.Name() == name {
+					r.Register(s)
+				}
+			}
+		}
+		b.SetStrategies(r)
+		rating, e := b.Rate()
+		if e != nil {
+			t.Fatal(e.Error())
+		}
+		if rating.Guessing || rating.Level != TrialAndError || rating.Hardest != "Forcing Chains" {
+			t.Errorf("Unexpected rating: %s %.1f needing %s", rating.Level, rating.Score, rating.Hardest)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
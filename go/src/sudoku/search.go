@@ -0,0 +1,169 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// cellState holds the value and candidates of a single cell so a board can
+// be put back the way it was after a guess turns out to be wrong.
+type cellState struct {
+	value      int
+	candidates []int
+}
+
+// snapshot records the value and candidates of every cell of the board.
+func (b *Board) snapshot() [][]cellState {
+	state := make([][]cellState, b.maxValue)
+	for row := 1; row <= b.maxValue; row++ {
+		state[row-1] = make([]cellState, b.maxValue)
+		for col := 1; col <= b.maxValue; col++ {
+			cell, e := b.getCell(col, row)
+			if e == nil {
+				state[row-1][col-1] = cellState{cell.GetValue(), cell.GetCandidates().GetAllMembers()}
+			}
+		}
+	}
+	return state
+}
+
+// restore puts every cell of the board back to a state taken by snapshot.
+func (b *Board) restore(state [][]cellState) {
+	for row := 1; row <= b.maxValue; row++ {
+		for col := 1; col <= b.maxValue; col++ {
+			cell, e := b.getCell(col, row)
+			if e == nil {
+				s := state[row-1][col-1]
+				cell.SetValue(s.value)
+				if s.value == -1 {
+					cell.SetCandidates(s.candidates)
+				}
+			}
+		}
+	}
+}
+
+// propagate repeatedly applies the hidden single and naked pair eliminations
+// to every cell till no more changes are made.  False is returned if the
+// board ends up in a contradictory state.
+func (b *Board) propagate() bool {
+	changed := true
+	for changed {
+		changed = false
+		for col := 1; col <= b.maxValue; col++ {
+			for row := 1; row <= b.maxValue; row++ {
+				if b.FindHiddenSingle(col, row) {
+					changed = true
+				}
+				if b.FindHiddenSingleInHouse(col, row) {
+					changed = true
+				}
+				if b.FindNakedPair(col, row) {
+					changed = true
+				}
+			}
+		}
+	}
+	ok, _ := b.IsValid()
+	return ok
+}
+
+// fewestCandidates locates the undetermined cell with the smallest number of
+// candidate values.  False is returned if every cell has been determined.
+func (b *Board) fewestCandidates() (int, int, bool) {
+	bestColumn, bestRow, bestCount := 0, 0, b.maxValue+1
+	for row := 1; row <= b.maxValue; row++ {
+		for col := 1; col <= b.maxValue; col++ {
+			cell, e := b.getCell(col, row)
+			if e == nil && !cell.Determined() {
+				if cell.NumPossibilities() < bestCount {
+					bestColumn, bestRow, bestCount = col, row, cell.NumPossibilities()
+				}
+			}
+		}
+	}
+	return bestColumn, bestRow, bestCount <= b.maxValue
+}
+
+// search performs a depth first search for solutions.  At every node the
+// board is reduced by propagate, then each candidate of the cell with the
+// fewest candidates is tried in turn.  The board state is restored whenever a
+// guess has been explored.  The search stops once limit solutions have been
+// found, leaving the board holding the last one, and the number of solutions
+// found is returned.  Unless path is nil, the steps leading to the last
+// solution found are added to it: a "Guess" step for each candidate tried,
+// and a "Propagation" step for the values propagate then placed.
+func (b *Board) search(limit int, path *[]Deduction) int {
+	var unsolved []Location
+	if path != nil {
+		unsolved = b.unsolvedLocations()
+	}
+	if !b.propagate() {
+		return 0
+	}
+	if placed := b.solvedSince(unsolved); len(placed) > 0 {
+		d := Deduction{Technique: "Propagation", Placements: placed}
+		for _, c := range placed {
+			d.Cells = append(d.Cells, c.Location)
+		}
+		*path = append(*path, d)
+	}
+	column, row, found := b.fewestCandidates()
+	if !found {
+		if b.AllCellsDetermined() {
+			return 1
+		}
+		return 0
+	}
+
+	cell, e := b.getCell(column, row)
+	if e != nil {
+		return 0
+	}
+	candidates := cell.GetCandidates().GetAllMembers()
+	sort.Ints(candidates)
+
+	count := 0
+	state := b.snapshot()
+	for _, v := range candidates {
+		b.SetValue(column, row, v)
+		steps := 0
+		if path != nil {
+			steps = len(*path)
+			guess := Candidate{Location{column, row}, v}
+			d := Deduction{Technique: "Guess", Cells: []Location{guess.Location}, Digits: candidates}
+			d.Placements = []Candidate{guess}
+			*path = append(*path, d)
+		}
+		count += b.search(limit-count, path)
+		if count >= limit {
+			return count
+		}
+		b.restore(state)
+		if path != nil {
+			*path = (*path)[:steps]
+		}
+	}
+	return count
+}
+
+// CountSolutions explores the search space from the current state of the board
+// and returns the number of solutions found, stopping once limit solutions have
+// been found.  The board is left unchanged.
+func (b *Board) CountSolutions(limit int) int {
+	if limit < 1 {
+		return 0
+	}
+	if ok, _ := b.IsValid(); !ok {
+		return 0
+	}
+	state := b.snapshot()
+	count := b.search(limit, nil)
+	b.restore(state)
+	return count
+}
+
+// HasUniqueSolution determines if exactly one solution exists for the current
+// state of the board.
+func (b *Board) HasUniqueSolution() bool {
+	return b.CountSolutions(2) == 1
+}
@@ -0,0 +1,191 @@
+package sudoku
+
+import (
+	"math/rand"
+	"testing"
+)
+
+var difficultBoard = [][]int{
+	{-1, 2, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, 6, -1, -1, -1, -1, 3},
+	{-1, 7, 4, -1, 8, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, 3, -1, -1, 2},
+	{-1, 8, -1, -1, 4, -1, -1, 1, -1},
+	{6, -1, -1, 5, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, 1, -1, 7, 8, -1},
+	{5, -1, -1, -1, -1, 9, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, 4, -1},
+}
+
+var difficultBoardSolution = [][]int{
+	{1, 2, 6, 4, 3, 7, 9, 5, 8},
+	{8, 9, 5, 6, 2, 1, 4, 7, 3},
+	{3, 7, 4, 9, 8, 5, 1, 2, 6},
+	{4, 5, 7, 1, 9, 3, 8, 6, 2},
+	{9, 8, 3, 2, 4, 6, 5, 1, 7},
+	{6, 1, 2, 5, 7, 8, 3, 9, 4},
+	{2, 6, 9, 3, 1, 4, 7, 8, 5},
+	{5, 4, 8, 7, 6, 9, 2, 3, 1},
+	{7, 3, 1, 8, 5, 2, 6, 4, 9},
+}
+
+var noSolutionBoard = [][]int{
+	{1, 2, 3, 4, 5, 6, 7, 8, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, 9},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+	{-1, -1, -1, -1, -1, -1, -1, -1, -1},
+}
+
+func TestSolveDifficult(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		if !b.Solve() {
+			t.Errorf("Failed to solve difficult board.")
+		} else {
+			board, eBoard := b.GetRepresentation()
+			if board != nil {
+				if !compare2dArrays(difficultBoardSolution, board) {
+					t.Error("Computed solution does not match solution.")
+				}
+			} else {
+				t.Error(eBoard.Error())
+			}
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSolveNoSolution(t *testing.T) {
+	b, e := NewBoardInitialize(noSolutionBoard)
+	if e == nil {
+		if b.Solve() {
+			t.Errorf("Board without a solution reported as solved.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 3})
+		state := b.snapshot()
+		b.SetValue(1, 1, 1)
+		b.SetValue(3, 1, 6)
+		b.restore(state)
+
+		c, _ := b.getCell(1, 1)
+		if c.Determined() || c.NumPossibilities() != 2 || !c.Contains(1) || !c.Contains(3) {
+			t.Errorf("Candidates at (1, 1) not restored.")
+		}
+		v, _ := b.GetValue(3, 1)
+		if v != -1 {
+			t.Errorf("Value at (3, 1) expected to be restored to -1, not %d.", v)
+		}
+		board, _ := b.GetRepresentation()
+		if !compare2dArrays(difficultBoard, board) {
+			t.Error("Restored board does not match original board.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestCountSolutions(t *testing.T) {
+	b, e := NewBoardInitialize(difficultBoard)
+	if e == nil {
+		count := b.CountSolutions(10)
+		if count != 1 {
+			t.Errorf("Expected 1 solution, instead found %d.", count)
+		}
+		if !b.HasUniqueSolution() {
+			t.Errorf("Difficult board not reported as having a unique solution.")
+		}
+		board, _ := b.GetRepresentation()
+		if !compare2dArrays(difficultBoard, board) {
+			t.Error("Counting solutions changed the board.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestCountSolutionsLimit(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		count := b.CountSolutions(3)
+		if count != 3 {
+			t.Errorf("Expected the search to stop at 3 solutions, instead found %d.", count)
+		}
+		if b.HasUniqueSolution() {
+			t.Errorf("Empty board reported as having a unique solution.")
+		}
+		if b.CountSolutions(0) != 0 {
+			t.Errorf("A limit of 0 should not find any solutions.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestCountSolutionsNone(t *testing.T) {
+	b, e := NewBoardInitialize(noSolutionBoard)
+	if e == nil {
+		count := b.CountSolutions(2)
+		if count != 0 {
+			t.Errorf("Expected no solutions, instead found %d.", count)
+		}
+		if b.HasUniqueSolution() {
+			t.Errorf("Board without a solution reported as having a unique solution.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSolveSparseBoard(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	solution, e := NewBoard(4, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if !solution.randomSolution(rng) {
+		t.Fatal("Failed to fill board.")
+	}
+	grid, _ := solution.GetRepresentation()
+	for r := range grid {
+		for c := range grid[r] {
+			if rng.Intn(100) < 65 {
+				grid[r][c] = -1
+			}
+		}
+	}
+	b, e := NewBoardInitialize(grid)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	// Forcing chains assume every candidate in turn, which on a board this
+	// open costs far more than searching.
+	b.Strategies().Disable("Forcing Chains")
+	if !b.Solve() {
+		t.Fatal("Failed to solve sparse board.")
+	}
+	if ok, e := b.IsValid(); !ok {
+		t.Error(e.Error())
+	}
+	board, _ := b.GetRepresentation()
+	for r := range grid {
+		for c := range grid[r] {
+			if grid[r][c] != -1 && board[r][c] != grid[r][c] {
+				t.Errorf("Given %d at (%d, %d) changed to %d.", grid[r][c], c+1, r+1, board[r][c])
+			}
+		}
+	}
+}
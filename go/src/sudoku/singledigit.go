@@ -0,0 +1,167 @@
+package sudoku
+
+// linkedPairs calls fn with every two conjugate pairs for a value joined by an
+// end of one seeing an end of the other, along with the houses of the pairs.
+// The ends seeing each other are passed first, followed by the other ends,
+// at least one of which must hold the value.
+func (b *Board) linkedPairs(value int, fn func(linked [2]Location, ends [2]Location, houses [2]House)) {
+	pairs, houses := b.conjugatePairs(value)
+	for i := 0; i < len(pairs); i++ {
+		for j := i + 1; j < len(pairs); j++ {
+			p, q := pairs[i], pairs[j]
+			if p[0] == q[0] || p[0] == q[1] || p[1] == q[0] || p[1] == q[1] {
+				continue
+			}
+			for a := 0; a < 2; a++ {
+				for c := 0; c < 2; c++ {
+					if b.sees(p[a], q[c]) && !b.sees(p[1-a], q[1-c]) {
+						fn([2]Location{p[a], q[c]}, [2]Location{p[1-a], q[1-c]}, [2]House{houses[i], houses[j]})
+					}
+				}
+			}
+		}
+	}
+}
+
+// singleDigitPattern eliminates value from the cells seeing both ends of two
+// linked conjugate pairs whose houses are of the kinds accepted by match.
+func (b *Board) singleDigitPattern(technique string, match func(linked [2]Location, houses [2]House) bool) []Deduction {
+	var rtnval []Deduction
+	for v := 1; v <= b.maxValue; v++ {
+		b.linkedPairs(v, func(linked [2]Location, ends [2]Location, houses [2]House) {
+			if !match(linked, houses) {
+				return
+			}
+			d := Deduction{Technique: technique, Cells: []Location{ends[0], linked[0], linked[1], ends[1]}, Houses: houses[:], Digits: []int{v}}
+			if found := b.eliminateSeen(d, v, ends[:]); found != nil {
+				rtnval = append(rtnval, *found)
+			}
+		})
+	}
+	return rtnval
+}
+
+// FindSkyscraper looks for two rows (or columns) each holding a value in only
+// two cells, where one end of each shares a column (or row).  One of the other
+// two ends must hold the value, so it is eliminated from every cell seeing
+// both.
+func (b *Board) FindSkyscraper() []Deduction {
+	return b.singleDigitPattern("Skyscraper", func(linked [2]Location, houses [2]House) bool {
+		switch {
+		case houses[0].Kind == RowHouse && houses[1].Kind == RowHouse:
+			return linked[0].Column == linked[1].Column
+		case houses[0].Kind == ColumnHouse && houses[1].Kind == ColumnHouse:
+			return linked[0].Row == linked[1].Row
+		}
+		return false
+	})
+}
+
+// FindTwoStringKite looks for a row and a column each holding a value in only
+// two cells, where one end of each lies in the same box.  One of the other two
+// ends must hold the value, so it is eliminated from every cell seeing both.
+func (b *Board) FindTwoStringKite() []Deduction {
+	return b.singleDigitPattern("2-String Kite", func(linked [2]Location, houses [2]House) bool {
+		lines := (houses[0].Kind == RowHouse && houses[1].Kind == ColumnHouse) ||
+			(houses[0].Kind == ColumnHouse && houses[1].Kind == RowHouse)
+		return lines && b.boxOf(linked[0]) == b.boxOf(linked[1])
+	})
+}
+
+// FindTurbotFish looks for two houses each holding a value in only two cells,
+// where one end of each sees the other, that are neither a Skyscraper nor a
+// 2-String Kite.  One of the other two ends must hold the value, so it is
+// eliminated from every cell seeing both.
+func (b *Board) FindTurbotFish() []Deduction {
+	return b.singleDigitPattern("Turbot Fish", func(linked [2]Location, houses [2]House) bool {
+		if houses[0].Kind == BoxHouse || houses[1].Kind == BoxHouse {
+			return true
+		}
+		if houses[0].Kind == houses[1].Kind {
+			if houses[0].Kind == RowHouse {
+				return linked[0].Column != linked[1].Column
+			}
+			return linked[0].Row != linked[1].Row
+		}
+		return b.boxOf(linked[0]) != b.boxOf(linked[1])
+	})
+}
+
+// emptyRectangle determines if every candidate for a value within a box lies
+// in the given row or column of the box, and not all in just one of them.
+func (b *Board) emptyRectangle(box int, row int, column int, value int) ([]Location, bool) {
+	cells := b.candidateLocations(House{BoxHouse, box}, value)
+	inRow, inColumn := false, false
+	for _, l := range cells {
+		if l.Row != row && l.Column != column {
+			return nil, false
+		}
+		inRow = inRow || l.Column != column
+		inColumn = inColumn || l.Row != row
+	}
+	return cells, inRow && inColumn
+}
+
+// FindEmptyRectangle looks for a box whose candidates for a value all lie in
+// one row and one column of the box, and a column (or row) outside of the box
+// holding the value in only two cells, one of them in the box's row (or
+// column).  Either the other end of the pair holds the value, or the box
+// holds it in its column (or row).  So the value is eliminated from the cell
+// where the other end's row meets the box's column (or the other end's column
+// meets the box's row).
+func (b *Board) FindEmptyRectangle() []Deduction {
+	var rtnval []Deduction
+	for v := 1; v <= b.maxValue; v++ {
+		pairs, houses := b.conjugatePairs(v)
+		for box := 1; box <= b.maxValue; box++ {
+			boxHouse := House{BoxHouse, box}
+			if b.valuePlaced(boxHouse, v) {
+				continue
+			}
+			boxCells := b.houseLocations(boxHouse)
+			first, last := boxCells[0], boxCells[len(boxCells)-1]
+			for row := first.Row; row <= last.Row; row++ {
+				for column := first.Column; column <= last.Column; column++ {
+					cells, ok := b.emptyRectangle(box, row, column, v)
+					if !ok {
+						continue
+					}
+					for i, pair := range pairs {
+						if houses[i].Kind == BoxHouse {
+							continue
+						}
+						for end := 0; end < 2; end++ {
+							linked, other := pair[end], pair[1-end]
+							target := Location{other.Column, row}
+							if houses[i].Kind == ColumnHouse {
+								if linked.Row != row {
+									continue
+								}
+								target = Location{column, other.Row}
+							} else if linked.Column != column {
+								continue
+							}
+							if b.boxOf(linked) == box || b.boxOf(target) == box {
+								continue
+							}
+							d := Deduction{Technique: "Empty Rectangle", Cells: append(append([]Location{}, cells...), pair[:]...), Houses: []House{boxHouse, houses[i]}, Digits: []int{v}}
+							if found := b.eliminateCells(d, v, []Location{target}); found != nil {
+								rtnval = append(rtnval, *found)
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindSingleDigitPatterns looks for Skyscrapers, 2-String Kites, Turbot Fish
+// and Empty Rectangles.
+func (b *Board) FindSingleDigitPatterns() []Deduction {
+	rtnval := b.FindSkyscraper()
+	rtnval = append(rtnval, b.FindTwoStringKite()...)
+	rtnval = append(rtnval, b.FindTurbotFish()...)
+	return append(rtnval, b.FindEmptyRectangle()...)
+}
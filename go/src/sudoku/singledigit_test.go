@@ -0,0 +1,107 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestFindSkyscraper(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 1, 3, []int{1, 5})
+		restrictValue(b, 4, 3, []int{1, 6})
+		deductions := b.FindSkyscraper()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 Skyscraper, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Skyscraper" || len(d.Cells) != 4 || len(d.Houses) != 2 {
+			t.Errorf("Unexpected deduction: %s with %d cells and %d houses.", d.Technique, len(d.Cells), len(d.Houses))
+		}
+		if len(d.Eliminations) != 4 {
+			t.Errorf("Expected 4 eliminations, not %d.", len(d.Eliminations))
+		}
+		for _, l := range []Location{{6, 2}, {6, 3}, {5, 5}, {5, 6}} {
+			if b.hasCandidate(l, 3) {
+				t.Errorf("3 not eliminated from %s.", l)
+			}
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindTwoStringKite(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		restrictValue(b, 2, 7, []int{2, 6})
+		restrictColumnValue(b, 1, 7, []int{3, 8})
+		if len(b.FindSkyscraper()) != 0 {
+			t.Error("Skyscraper found where only a 2-String Kite exists.")
+		}
+		deductions := b.FindTwoStringKite()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 2-String Kite, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "2-String Kite" {
+			t.Errorf("Unexpected technique: %s", d.Technique)
+		}
+		if len(d.Eliminations) != 1 || d.Eliminations[0] != (Candidate{Location{6, 8}, 7}) {
+			t.Errorf("Unexpected eliminations: %v", d.Eliminations)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindTurbotFish(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		for _, l := range b.houseLocations(House{BoxHouse, 1}) {
+			if l != (Location{1, 1}) && l != (Location{3, 3}) {
+				b.eliminate([]Candidate{{l, 4}})
+			}
+		}
+		restrictValue(b, 6, 4, []int{3, 8})
+		if len(b.FindSkyscraper()) != 0 || len(b.FindTwoStringKite()) != 0 {
+			t.Error("Skyscraper or 2-String Kite found where only a Turbot Fish exists.")
+		}
+		deductions := b.FindTurbotFish()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 Turbot Fish, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Turbot Fish" {
+			t.Errorf("Unexpected technique: %s", d.Technique)
+		}
+		if len(d.Eliminations) != 1 || d.Eliminations[0] != (Candidate{Location{8, 1}, 4}) {
+			t.Errorf("Unexpected eliminations: %v", d.Eliminations)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindEmptyRectangle(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Box 5 holds 6 only in row 5 and column 5.
+		for _, l := range []Location{{4, 4}, {6, 4}, {4, 6}, {6, 6}} {
+			b.eliminate([]Candidate{{l, 6}})
+		}
+		restrictColumnValue(b, 8, 6, []int{2, 5})
+		deductions := b.FindEmptyRectangle()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 Empty Rectangle, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Empty Rectangle" || d.Houses[0] != (House{BoxHouse, 5}) || d.Houses[1] != (House{ColumnHouse, 8}) {
+			t.Errorf("Unexpected deduction: %s in %v", d.Technique, d.Houses)
+		}
+		if len(d.Eliminations) != 1 || d.Eliminations[0] != (Candidate{Location{5, 2}, 6}) {
+			t.Errorf("Unexpected eliminations: %v", d.Eliminations)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
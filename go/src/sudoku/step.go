@@ -0,0 +1,166 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// solvedSince returns the values placed in the listed cells, which were
+// undetermined, since they were listed.
+func (b *Board) solvedSince(unsolved []Location) []Candidate {
+	var rtnval []Candidate
+	for _, l := range unsolved {
+		if v, e := b.GetValue(l.Column, l.Row); e == nil && v >= 1 {
+			rtnval = append(rtnval, Candidate{l, v})
+		}
+	}
+	return rtnval
+}
+
+// placedPeer returns a cell in one of the houses of a candidate's cell holding
+// the candidate's value.  False is returned if no such value is placed.
+func (b *Board) placedPeer(c Candidate) (Location, bool) {
+	for _, h := range b.housesOf(c.Location) {
+		for _, l := range b.houseLocations(h) {
+			if v, e := b.GetValue(l.Column, l.Row); e == nil && v == c.Value {
+				return l, true
+			}
+		}
+	}
+	return Location{}, false
+}
+
+// changeLog holds the changes made to a board while a pass of the solver is
+// recorded which the deductions of the strategies do not describe: candidates
+// removed by FindHiddenSingle as their value is placed in a house of their
+// cell, and cells left with a single candidate by an elimination.
+type changeLog struct {
+	eliminated []Candidate
+	solved     []Candidate
+}
+
+// startRecording begins logging the changes made to the board, to be
+// recorded as steps by record.  Nothing is logged on the copies of the board
+// made to follow the assumptions of forcing chains.
+func (b *Board) startRecording() {
+	if !b.branch {
+		b.changes = &changeLog{}
+	}
+}
+
+// logEliminated logs candidates removed as their value is placed in a house
+// of their cell.
+func (b *Board) logEliminated(l Location, values []int) {
+	if b.changes != nil {
+		for _, v := range values {
+			b.changes.eliminated = append(b.changes.eliminated, Candidate{l, v})
+		}
+	}
+}
+
+// logSolved logs a cell left with a single candidate by an elimination.
+func (b *Board) logSolved(c Candidate) {
+	if b.changes != nil {
+		b.changes.solved = append(b.changes.solved, c)
+	}
+}
+
+// peerEliminations returns as steps the logged candidates removed as their
+// value is placed in a house of their cell, other than from cells since
+// solved.  Each step lists the candidates eliminated by the value of one
+// placed cell along with the houses it shares with them, the steps ordered by
+// the first candidate they eliminate, taking cells row by row.
+func (b *Board) peerEliminations() []Deduction {
+	var rtnval []Deduction
+	index := make(map[Location]int)
+	eliminated := b.changes.eliminated
+	sort.Slice(eliminated, func(i, j int) bool {
+		if eliminated[i].Row != eliminated[j].Row {
+			return eliminated[i].Row < eliminated[j].Row
+		}
+		if eliminated[i].Column != eliminated[j].Column {
+			return eliminated[i].Column < eliminated[j].Column
+		}
+		return eliminated[i].Value < eliminated[j].Value
+	})
+	for _, c := range eliminated {
+		if b.numPossibilities(c.Location) == 0 {
+			continue
+		}
+		source, found := b.placedPeer(c)
+		if !found {
+			continue
+		}
+		i, seen := index[source]
+		if !seen {
+			i = len(rtnval)
+			index[source] = i
+			rtnval = append(rtnval, Deduction{Technique: "Peer Elimination", Cells: []Location{source}, Digits: []int{c.Value}})
+		}
+		rtnval[i].Eliminations = append(rtnval[i].Eliminations, c)
+	}
+	for i := range rtnval {
+		for _, h := range b.housesOf(rtnval[i].Cells[0]) {
+			for _, c := range rtnval[i].Eliminations {
+				if b.inHouse(c.Location, h) {
+					rtnval[i].Houses = append(rtnval[i].Houses, h)
+					break
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// record adds the deductions made since startRecording to the steps of the
+// board, then stops logging.  The logged peer eliminations are recorded too,
+// those due to values placed before the deductions coming first.  The logged
+// cells solved by an elimination, but not placed by one of the deductions,
+// are recorded as naked singles following them.
+func (b *Board) record(deductions []Deduction) {
+	if b.changes == nil {
+		return
+	}
+	placed := make(map[Location]bool)
+	for _, d := range deductions {
+		for _, c := range d.Placements {
+			placed[c.Location] = true
+		}
+	}
+	var singles []Deduction
+	for _, c := range b.changes.solved {
+		if !placed[c.Location] {
+			placed[c.Location] = true
+			d := Deduction{Technique: "Naked Single", Cells: []Location{c.Location}, Houses: b.housesOf(c.Location), Digits: []int{c.Value}}
+			d.Placements = []Candidate{c}
+			singles = append(singles, d)
+		}
+	}
+
+	var earlier, later []Deduction
+	for _, d := range b.peerEliminations() {
+		if placed[d.Cells[0]] {
+			later = append(later, d)
+		} else {
+			earlier = append(earlier, d)
+		}
+	}
+	b.steps = append(b.steps, earlier...)
+	b.steps = append(b.steps, deductions...)
+	b.steps = append(b.steps, singles...)
+	b.steps = append(b.steps, later...)
+	b.changes = nil
+}
+
+// Steps returns every deduction made by SinglePassSolve and Solve on the
+// board, in the order made, along with the peer eliminations removing placed
+// values from the candidates of their houses.  Each step names its technique,
+// the cells, houses and digits making up its pattern, and the values placed
+// or candidates eliminated.
+func (b *Board) Steps() []Deduction {
+	return b.steps
+}
+
+// ClearSteps forgets the steps recorded so far.
+func (b *Board) ClearSteps() {
+	b.steps = nil
+}
@@ -0,0 +1,173 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestSolveSteps(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		unsolved := b.unsolvedLocations()
+		if !b.Solve() {
+			t.Fatal("Failed to solve board.")
+		}
+		placed := make(map[Location]int)
+		for _, d := range b.Steps() {
+			if d.Technique == "" || d.Technique == "Search" {
+				t.Errorf("Unexpected step: %q", d.Technique)
+			}
+			if d.Technique == "Hidden Single" && len(d.Houses) != 1 {
+				t.Errorf("Expected the house of hidden single %v, not %v.", d.Placements, d.Houses)
+			}
+			for _, c := range d.Placements {
+				if _, done := placed[c.Location]; done {
+					t.Errorf("%s placed twice.", c.Location)
+				}
+				placed[c.Location] = c.Value
+				if solutionBoard1[c.Row-1][c.Column-1] != c.Value {
+					t.Errorf("Step %s placed %s.", d.Technique, c)
+				}
+			}
+		}
+		if len(placed) != len(unsolved) {
+			t.Errorf("Expected %d placements, not %d.", len(unsolved), len(placed))
+		}
+		b.ClearSteps()
+		if len(b.Steps()) != 0 {
+			t.Error("Steps not cleared.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestStepsNakedSingleAfterElimination(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetStrategies(NewRegistry(NewStrategy("Test", 1.0, func(b *Board) []Deduction {
+			d := Deduction{Technique: "Test", Eliminations: []Candidate{{Location{1, 1}, 1}}}
+			if !b.hasCandidate(Location{1, 1}, 1) {
+				return nil
+			}
+			b.eliminate(d.Eliminations)
+			return []Deduction{d}
+		})))
+		if !b.SinglePassSolve() {
+			t.Fatal("Test strategy made no progress.")
+		}
+		steps := b.Steps()
+		if len(steps) != 2 || steps[0].Technique != "Test" || steps[1].Technique != "Naked Single" {
+			t.Fatalf("Unexpected steps: %v", steps)
+		}
+		if len(steps[1].Placements) != 1 || steps[1].Placements[0] != (Candidate{Location{1, 1}, 2}) {
+			t.Errorf("Unexpected placements: %v", steps[1].Placements)
+		}
+		if len(steps[1].Houses) != 3 {
+			t.Errorf("Expected the 3 houses of the cell, not %v.", steps[1].Houses)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSolveSearchSteps(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetStrategies(NewRegistry())
+		steps, ok := b.SolveSteps()
+		if !ok {
+			t.Fatal("Failed to solve empty board.")
+		}
+		placed := make(map[Location]bool)
+		guesses := 0
+		for _, d := range steps {
+			if d.Technique == "Guess" {
+				guesses++
+			} else if d.Technique != "Propagation" {
+				t.Errorf("Unexpected step: %q", d.Technique)
+			}
+			for _, c := range d.Placements {
+				if placed[c.Location] {
+					t.Errorf("%s placed twice.", c.Location)
+				}
+				placed[c.Location] = true
+				if value, _ := b.GetValue(c.Column, c.Row); value != c.Value {
+					t.Errorf("Step %s placed %s, not the value %d of the solution.", d.Technique, c, value)
+				}
+			}
+		}
+		if guesses == 0 || len(placed) != 81 {
+			t.Errorf("Expected guesses placing 81 values, found %d guesses placing %d.", guesses, len(placed))
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestSolveStepsOfSolve(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		b.SinglePassSolve()
+		earlier := len(b.Steps())
+		steps, ok := b.SolveSteps()
+		if !ok {
+			t.Fatal("Failed to solve board.")
+		}
+		if len(steps) == 0 || earlier+len(steps) != len(b.Steps()) {
+			t.Errorf("Expected the %d steps after the first %d, found %d.", len(b.Steps())-earlier, earlier, len(steps))
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestStepsPeerElimination(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetValue(2, 2, 5)
+		b.SetStrategies(NewRegistry(NewStrategy("Naked Single", 1.2, eachCell("Naked Single", (*Board).FindHiddenSingle, nakedSingleHouses))))
+		b.SinglePassSolve()
+		steps := b.Steps()
+		if len(steps) != 1 || steps[0].Technique != "Peer Elimination" {
+			t.Fatalf("Unexpected steps: %v", steps)
+		}
+		d := steps[0]
+		if len(d.Cells) != 1 || d.Cells[0] != (Location{2, 2}) || len(d.Digits) != 1 || d.Digits[0] != 5 {
+			t.Errorf("Unexpected pattern: %v of %v", d.Cells, d.Digits)
+		}
+		if len(d.Eliminations) != 20 {
+			t.Errorf("Expected 20 eliminations, not %d.", len(d.Eliminations))
+		}
+		if len(d.Houses) != 3 {
+			t.Errorf("Expected the 3 houses of the cell, not %v.", d.Houses)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestStepsEveryElimination(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		for pass := 0; pass < 5; pass++ {
+			before := b.snapshot()
+			b.ClearSteps()
+			b.SinglePassSolve()
+			var eliminations []Candidate
+			for _, d := range b.Steps() {
+				eliminations = append(eliminations, d.Eliminations...)
+			}
+			for _, l := range b.unsolvedLocations() {
+				for _, v := range before[l.Row-1][l.Column-1].candidates {
+					c := Candidate{l, v}
+					if !b.hasCandidate(l, v) && !containsCandidate(eliminations, c) {
+						t.Errorf("Elimination of %s not recorded in pass %d.", c, pass+1)
+					}
+				}
+			}
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -0,0 +1,258 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Strategy describes a solving technique.  Apply looks for the technique on a
+// board, applying and returning each deduction found.  Difficulty weighs the
+// technique on a scale like Sudoku Explainer's, from 1.0 for the simplest
+// techniques to around 9.0 for forcing chains.
+type Strategy interface {
+	Name() string
+	Difficulty() float64
+	Apply(b *Board) []Deduction
+}
+
+// strategy is a Strategy applying a function.
+type strategy struct {
+	name       string
+	difficulty float64
+	apply      func(b *Board) []Deduction
+}
+
+// NewStrategy creates a strategy given its name, difficulty and the function
+// applying it.
+func NewStrategy(name string, difficulty float64, apply func(b *Board) []Deduction) Strategy {
+	return Strategy(&strategy{name, difficulty, apply})
+}
+
+// Name returns the name of the technique.
+func (s *strategy) Name() string {
+	return s.name
+}
+
+// Difficulty returns the difficulty weight of the technique.
+func (s *strategy) Difficulty() float64 {
+	return s.difficulty
+}
+
+// Apply looks for the technique on a board.
+func (s *strategy) Apply(b *Board) []Deduction {
+	return s.apply(b)
+}
+
+// eachCell applies a technique working on one cell at a time to every cell,
+// returning a deduction for each value placed along with the houses given by
+// justify.
+func eachCell(technique string, find func(b *Board, column int, row int) bool, justify func(b *Board, c Candidate) []House) func(b *Board) []Deduction {
+	return func(b *Board) []Deduction {
+		var rtnval []Deduction
+		for col := 1; col <= b.maxValue; col++ {
+			for row := 1; row <= b.maxValue; row++ {
+				cell, e := b.getCell(col, row)
+				if e != nil || cell.Determined() || !find(b, col, row) {
+					continue
+				}
+				c := Candidate{Location{col, row}, cell.GetValue()}
+				d := Deduction{Technique: technique, Cells: []Location{c.Location}, Houses: justify(b, c), Digits: []int{c.Value}}
+				d.Placements = []Candidate{c}
+				rtnval = append(rtnval, d)
+			}
+		}
+		return rtnval
+	}
+}
+
+// nakedSingleHouses returns the houses of a naked single, whose values leave
+// the cell a single candidate.
+func nakedSingleHouses(b *Board, c Candidate) []House {
+	return b.housesOf(c.Location)
+}
+
+// hiddenSingleHouses returns the house of a hidden single, in which no other
+// cell can hold its value.
+func hiddenSingleHouses(b *Board, c Candidate) []House {
+	for _, h := range b.housesOf(c.Location) {
+		if len(b.candidateLocations(h, c.Value)) == 0 {
+			return []House{h}
+		}
+	}
+	return nil
+}
+
+// branchDifficulty is the hardest technique used on the copies of the board
+// made to follow the assumptions of forcing chains, keeping them cheap.
+const branchDifficulty = 4.0
+
+// Registry holds an ordered list of strategies, each of which may be
+// disabled.  The solver applies the enabled strategies in order.
+type Registry struct {
+	strategies []Strategy
+	disabled   map[string]bool
+}
+
+// NewRegistry creates a registry holding the strategies in the order given.
+func NewRegistry(strategies ...Strategy) *Registry {
+	return &Registry{append([]Strategy{}, strategies...), make(map[string]bool)}
+}
+
+// DefaultRegistry creates a registry holding every technique of the package,
+// easiest first.  Naked singles come first, as finding them also removes the
+// values placed in each cell's houses from its candidates.
+func DefaultRegistry() *Registry {
+	fish := func(size int, finned bool) func(b *Board) []Deduction {
+		return func(b *Board) []Deduction { return b.FindFish(size, finned) }
+	}
+	naked := func(size int) func(b *Board) []Deduction {
+		return func(b *Board) []Deduction { return b.FindNakedSubsets(size) }
+	}
+	hidden := func(size int) func(b *Board) []Deduction {
+		return func(b *Board) []Deduction { return b.FindHiddenSubsets(size) }
+	}
+	return NewRegistry(
+		NewStrategy("Naked Single", 1.2, eachCell("Naked Single", (*Board).FindHiddenSingle, nakedSingleHouses)),
+		NewStrategy("Hidden Single", 1.5, eachCell("Hidden Single", (*Board).FindHiddenSingleInHouse, hiddenSingleHouses)),
+		NewStrategy("Pointing", 2.6, (*Board).FindPointing),
+		NewStrategy("Claiming", 2.8, (*Board).FindClaiming),
+		NewStrategy("Naked Pair", 3.0, naked(2)),
+		NewStrategy("X-Wing", 3.2, fish(2, false)),
+		NewStrategy("Hidden Pair", 3.4, hidden(2)),
+		NewStrategy("Finned X-Wing", 3.4, fish(2, true)),
+		NewStrategy("Naked Triple", 3.6, naked(3)),
+		NewStrategy("Swordfish", 3.8, fish(3, false)),
+		NewStrategy("Hidden Triple", 4.0, hidden(3)),
+		NewStrategy("Skyscraper", 4.0, (*Board).FindSkyscraper),
+		NewStrategy("2-String Kite", 4.1, (*Board).FindTwoStringKite),
+		NewStrategy("Finned Swordfish", 4.1, fish(3, true)),
+		NewStrategy("Turbot Fish", 4.2, (*Board).FindTurbotFish),
+		NewStrategy("Empty Rectangle", 4.2, (*Board).FindEmptyRectangle),
+		NewStrategy("XY-Wing", 4.2, (*Board).FindXYWing),
+		NewStrategy("XYZ-Wing", 4.4, (*Board).FindXYZWing),
+		NewStrategy("W-Wing", 4.4, (*Board).FindWWing),
+		NewStrategy("Unique Rectangle", 4.5, (*Board).FindUniqueRectangles),
+		NewStrategy("Simple Coloring", 4.6, (*Board).FindSimpleColoring),
+		NewStrategy("Multi-Coloring", 4.8, (*Board).FindMultiColoring),
+		NewStrategy("Naked Quad", 5.0, naked(4)),
+		NewStrategy("Sue de Coq", 5.0, (*Board).FindSueDeCoq),
+		NewStrategy("Jellyfish", 5.2, fish(4, false)),
+		NewStrategy("Hidden Quad", 5.4, hidden(4)),
+		NewStrategy("Finned Jellyfish", 5.4, fish(4, true)),
+		NewStrategy("BUG+1", 5.6, (*Board).FindBUG),
+		NewStrategy("X-Cycle", 6.5, (*Board).FindXCycles),
+		NewStrategy("XY-Chain", 6.6, (*Board).FindXYChains),
+		NewStrategy("AIC", 7.0, (*Board).FindAICs),
+		NewStrategy("ALS-XZ", 7.5, (*Board).FindALSXZ),
+		NewStrategy("ALS-XY-Wing", 7.8, (*Board).FindALSXYWing),
+		NewStrategy("Death Blossom", 8.0, (*Board).FindDeathBlossom),
+		NewStrategy("Forcing Chains", 8.5, func(b *Board) []Deduction {
+			if !b.consistent() {
+				return nil
+			}
+			return b.FindForcingChains()
+		}),
+	)
+}
+
+// index returns the position of the named strategy, or -1 if it is not held.
+func (r *Registry) index(name string) int {
+	for i, s := range r.strategies {
+		if s.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// unknownStrategy reports a strategy name not held by a registry.
+func unknownStrategy(name string) error {
+	msg := fmt.Sprintf("Unknown strategy: %s", name)
+	return errors.New(msg)
+}
+
+// Register adds a strategy after every strategy of the same or lower
+// difficulty, replacing any strategy of the same name.
+func (r *Registry) Register(s Strategy) {
+	if i := r.index(s.Name()); i >= 0 {
+		r.strategies = append(r.strategies[:i], r.strategies[i+1:]...)
+	}
+	i := 0
+	for i < len(r.strategies) && r.strategies[i].Difficulty() <= s.Difficulty() {
+		i++
+	}
+	r.strategies = append(r.strategies, nil)
+	copy(r.strategies[i+1:], r.strategies[i:])
+	r.strategies[i] = s
+}
+
+// Enable allows the named strategy to be applied.
+func (r *Registry) Enable(name string) error {
+	if r.index(name) < 0 {
+		return unknownStrategy(name)
+	}
+	delete(r.disabled, name)
+	return nil
+}
+
+// Disable stops the named strategy from being applied.
+func (r *Registry) Disable(name string) error {
+	if r.index(name) < 0 {
+		return unknownStrategy(name)
+	}
+	r.disabled[name] = true
+	return nil
+}
+
+// Enabled determines if the named strategy is held and applied.
+func (r *Registry) Enabled(name string) bool {
+	return r.index(name) >= 0 && !r.disabled[name]
+}
+
+// Reorder moves the named strategies to the front, in the order given.  The
+// other strategies follow in their current order.
+func (r *Registry) Reorder(names ...string) error {
+	var front []Strategy
+	for _, name := range names {
+		i := r.index(name)
+		if i < 0 {
+			return unknownStrategy(name)
+		}
+		for _, s := range front {
+			if s.Name() == name {
+				msg := fmt.Sprintf("Strategy named more than once: %s", name)
+				return errors.New(msg)
+			}
+		}
+		front = append(front, r.strategies[i])
+	}
+	rest := make([]Strategy, 0, len(r.strategies))
+	for _, s := range r.strategies {
+		if !containsStrategy(front, s) {
+			rest = append(rest, s)
+		}
+	}
+	r.strategies = append(front, rest...)
+	return nil
+}
+
+// containsStrategy determines if a strategy is a member of strategies.
+func containsStrategy(strategies []Strategy, s Strategy) bool {
+	for _, o := range strategies {
+		if o == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Strategies returns the enabled strategies in the order they are applied.
+func (r *Registry) Strategies() []Strategy {
+	rtnval := make([]Strategy, 0, len(r.strategies))
+	for _, s := range r.strategies {
+		if !r.disabled[s.Name()] {
+			rtnval = append(rtnval, s)
+		}
+	}
+	return rtnval
+}
@@ -0,0 +1,102 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// strategyNames returns the names of the strategies in order.
+func strategyNames(strategies []Strategy) []string {
+	var rtnval []string
+	for _, s := range strategies {
+		rtnval = append(rtnval, s.Name())
+	}
+	return rtnval
+}
+
+func TestDefaultRegistryOrder(t *testing.T) {
+	strategies := DefaultRegistry().Strategies()
+	if len(strategies) == 0 || strategies[0].Name() != "Naked Single" {
+		t.Fatalf("Unexpected first strategy: %v", strategyNames(strategies))
+	}
+	for i := 1; i < len(strategies); i++ {
+		if strategies[i].Difficulty() < strategies[i-1].Difficulty() {
+			t.Errorf("%s is easier than %s, which comes before it.", strategies[i].Name(), strategies[i-1].Name())
+		}
+	}
+}
+
+func TestRegistryRegister(t *testing.T) {
+	none := func(b *Board) []Deduction { return nil }
+	r := NewRegistry(NewStrategy("A", 1.0, none), NewStrategy("C", 3.0, none))
+	r.Register(NewStrategy("B", 2.0, none))
+	r.Register(NewStrategy("D", 3.0, none))
+	r.Register(NewStrategy("A", 4.0, none))
+	names := strategyNames(r.Strategies())
+	expected := []string{"B", "C", "D", "A"}
+	if len(names) != len(expected) {
+		t.Fatalf("Expected %v, not %v.", expected, names)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("Expected %v, not %v.", expected, names)
+			break
+		}
+	}
+}
+
+func TestRegistryEnableDisable(t *testing.T) {
+	r := DefaultRegistry()
+	n := len(r.Strategies())
+	if e := r.Disable("X-Wing"); e != nil {
+		t.Error(e.Error())
+	}
+	if r.Enabled("X-Wing") || len(r.Strategies()) != n-1 {
+		t.Error("X-Wing not disabled.")
+	}
+	if e := r.Enable("X-Wing"); e != nil {
+		t.Error(e.Error())
+	}
+	if !r.Enabled("X-Wing") || len(r.Strategies()) != n {
+		t.Error("X-Wing not enabled.")
+	}
+	if r.Disable("Y-Wing") == nil || r.Enable("Y-Wing") == nil {
+		t.Error("Unknown strategy accepted.")
+	}
+}
+
+func TestRegistryReorder(t *testing.T) {
+	r := DefaultRegistry()
+	if e := r.Reorder("AIC", "Hidden Single"); e != nil {
+		t.Fatal(e.Error())
+	}
+	names := strategyNames(r.Strategies())
+	if names[0] != "AIC" || names[1] != "Hidden Single" || names[2] != "Naked Single" {
+		t.Errorf("Unexpected order: %v", names[:3])
+	}
+	if r.Reorder("Pointing", "Pointing") == nil {
+		t.Error("Strategy named twice accepted.")
+	}
+	if r.Reorder("Nothing") == nil {
+		t.Error("Unknown strategy accepted.")
+	}
+}
+
+func TestSinglePassSolveStrategies(t *testing.T) {
+	b, e := NewBoardInitialize(solvableBoard1)
+	if e == nil {
+		applied := 0
+		b.SetStrategies(NewRegistry(NewStrategy("Counting", 1.0, func(b *Board) []Deduction {
+			applied++
+			return nil
+		})))
+		if b.SinglePassSolve() || applied != 1 {
+			t.Errorf("Expected the only strategy to be applied once without progress, applied %d times.", applied)
+		}
+		b.SetStrategies(nil)
+		if !b.SinglePassSolve() {
+			t.Error("Default strategies made no progress.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
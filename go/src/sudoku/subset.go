@@ -0,0 +1,180 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// subsetNames names subsets by their size, such as a Pair for 2.
+var subsetNames = map[int]string{2: "Pair", 3: "Triple", 4: "Quad"}
+
+// FindNakedSubset looks for size cells of a house whose candidates, taken
+// together, number exactly size values.  Those values must be placed in those
+// cells, so they are eliminated from every other cell of the house.  The cells
+// need not hold identical candidates.  A deduction is returned for each naked
+// subset that removed candidates.
+func (b *Board) FindNakedSubset(house House, size int) []Deduction {
+	var rtnval []Deduction
+	name, ok := subsetNames[size]
+	if !ok {
+		return nil
+	}
+
+	locations := b.houseLocations(house)
+	unsolved := 0
+	var members []int
+	for i, l := range locations {
+		cell, e := b.getCell(l.Column, l.Row)
+		if e != nil || cell.Determined() {
+			continue
+		}
+		unsolved++
+		n := cell.NumPossibilities()
+		if 2 <= n && n <= size {
+			members = append(members, i)
+		}
+	}
+	if unsolved <= size {
+		return nil
+	}
+
+	combinations(len(members), size, func(indices []int) bool {
+		cellIndices := make(map[int]bool)
+		var digits []int
+		for _, i := range indices {
+			cellIndices[members[i]] = true
+			cell, _ := b.getCell(locations[members[i]].Column, locations[members[i]].Row)
+			if cell.Determined() {
+				// Solved by an earlier subset of this house.
+				return true
+			}
+			for _, v := range cell.GetCandidates().GetAllMembers() {
+				if !containsInt(digits, v) {
+					digits = append(digits, v)
+				}
+			}
+		}
+		if len(digits) != size {
+			return true
+		}
+		sort.Ints(digits)
+
+		d := Deduction{Technique: "Naked " + name, Houses: []House{house}, Digits: digits}
+		for i, l := range locations {
+			if cellIndices[i] {
+				d.Cells = append(d.Cells, l)
+				continue
+			}
+			for _, v := range digits {
+				if b.hasCandidate(l, v) {
+					d.Eliminations = append(d.Eliminations, Candidate{l, v})
+				}
+			}
+		}
+		if len(d.Eliminations) > 0 {
+			b.eliminate(d.Eliminations)
+			rtnval = append(rtnval, d)
+		}
+		return true
+	})
+	return rtnval
+}
+
+// FindNakedSubsets looks for naked subsets of the given size in every row,
+// column and box of the board.
+func (b *Board) FindNakedSubsets(size int) []Deduction {
+	var rtnval []Deduction
+	for _, h := range b.houses() {
+		rtnval = append(rtnval, b.FindNakedSubset(h, size)...)
+	}
+	return rtnval
+}
+
+// FindHiddenSubset looks for size values of a house which can only be placed in
+// the same size cells.  Those cells must hold those values, so every other
+// candidate is eliminated from them.  A deduction is returned for each hidden
+// subset that removed candidates.
+func (b *Board) FindHiddenSubset(house House, size int) []Deduction {
+	var rtnval []Deduction
+	name, ok := subsetNames[size]
+	if !ok {
+		return nil
+	}
+
+	// Find where each value still to be placed in the house could go.
+	locations := b.houseLocations(house)
+	unsolved := 0
+	var values []int
+	var positions [][]int
+	for v := 1; v <= b.maxValue; v++ {
+		if b.valuePlaced(house, v) {
+			continue
+		}
+		unsolved++
+		var p []int
+		for i, l := range locations {
+			if b.hasCandidate(l, v) {
+				p = append(p, i)
+			}
+		}
+		if 2 <= len(p) && len(p) <= size {
+			values = append(values, v)
+			positions = append(positions, p)
+		}
+	}
+	if unsolved <= size {
+		return nil
+	}
+
+	combinations(len(values), size, func(indices []int) bool {
+		cellIndices := make(map[int]bool)
+		digits := make([]int, 0, size)
+		for _, i := range indices {
+			digits = append(digits, values[i])
+			for _, p := range positions[i] {
+				cellIndices[p] = true
+			}
+		}
+		if len(cellIndices) != size {
+			return true
+		}
+
+		d := Deduction{Technique: "Hidden " + name, Houses: []House{house}, Digits: digits}
+		for i, l := range locations {
+			if !cellIndices[i] {
+				continue
+			}
+			d.Cells = append(d.Cells, l)
+			for v := 1; v <= b.maxValue; v++ {
+				if !containsInt(digits, v) && b.hasCandidate(l, v) {
+					d.Eliminations = append(d.Eliminations, Candidate{l, v})
+				}
+			}
+		}
+		if len(d.Eliminations) > 0 {
+			b.eliminate(d.Eliminations)
+			rtnval = append(rtnval, d)
+		}
+		return true
+	})
+	return rtnval
+}
+
+// FindHiddenSubsets looks for hidden subsets of the given size in every row,
+// column and box of the board.
+func (b *Board) FindHiddenSubsets(size int) []Deduction {
+	var rtnval []Deduction
+	for _, h := range b.houses() {
+		rtnval = append(rtnval, b.FindHiddenSubset(h, size)...)
+	}
+	return rtnval
+}
+
+// containsInt determines if value is a member of values.
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+// checkCandidates reports an error unless the cell at a location holds
+// exactly the expected candidates.
+func checkCandidates(t *testing.T, b *Board, column int, row int, expected []int) {
+	c, e := b.getCell(column, row)
+	if e != nil {
+		t.Error(e.Error())
+		return
+	}
+	if c.NumPossibilities() != len(expected) {
+		t.Errorf("Expected %d candidates at (%d, %d), not %d.", len(expected), column, row, c.NumPossibilities())
+	}
+	for _, v := range expected {
+		if !c.Contains(v) {
+			t.Errorf("Expected candidate %d at (%d, %d).", v, column, row)
+		}
+	}
+}
+
+func TestFindHiddenPair(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2, 3, 6})
+		b.SetCandidates(2, 1, []int{1, 2, 4, 7})
+		for col := 3; col <= 9; col++ {
+			b.SetCandidates(col, 1, []int{3, 4, 5, 6, 7, 8, 9})
+		}
+		if len(b.FindHiddenSubset(House{RowHouse, 1}, 3)) != 0 {
+			t.Errorf("Hidden triple found where only a hidden pair exists.")
+		}
+		deductions := b.FindHiddenSubset(House{RowHouse, 1}, 2)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 hidden pair, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Hidden Pair" || len(d.Eliminations) != 4 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+		checkCandidates(t, b, 1, 1, []int{1, 2})
+		checkCandidates(t, b, 2, 1, []int{1, 2})
+		checkCandidates(t, b, 3, 1, []int{3, 4, 5, 6, 7, 8, 9})
+
+		if len(b.FindHiddenSubset(House{RowHouse, 1}, 2)) != 0 {
+			t.Errorf("Hidden pair reported again once nothing is left to eliminate.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindHiddenTriple(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(5, 1, []int{4, 5, 8, 9})
+		b.SetCandidates(5, 2, []int{5, 6, 9})
+		b.SetCandidates(5, 3, []int{1, 4, 6})
+		for row := 4; row <= 9; row++ {
+			b.SetCandidates(5, row, []int{1, 2, 3, 7, 8, 9})
+		}
+		deductions := b.FindHiddenSubsets(3)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 hidden triple, found %d.", len(deductions))
+		}
+		if deductions[0].Houses[0] != (House{ColumnHouse, 5}) {
+			t.Errorf("Hidden triple expected in column 5, not %s.", deductions[0].Houses[0])
+		}
+		checkCandidates(t, b, 5, 1, []int{4, 5})
+		checkCandidates(t, b, 5, 2, []int{5, 6})
+		checkCandidates(t, b, 5, 3, []int{4, 6})
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindNakedTriple(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// The cells do not hold identical candidates, but between them hold only 1, 5 and 8.
+		b.SetCandidates(1, 4, []int{1, 5})
+		b.SetCandidates(4, 4, []int{5, 8})
+		b.SetCandidates(9, 4, []int{1, 5, 8})
+		b.SetCandidates(2, 4, []int{1, 2, 3, 8})
+		if len(b.FindNakedSubset(House{RowHouse, 4}, 2)) != 0 {
+			t.Errorf("Naked pair found where only a naked triple exists.")
+		}
+		deductions := b.FindNakedSubset(House{RowHouse, 4}, 3)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 naked triple, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Naked Triple" {
+			t.Errorf("Unexpected technique: %s", d.Technique)
+		}
+		if len(d.Cells) != 3 || d.Cells[0] != (Location{1, 4}) || d.Cells[2] != (Location{9, 4}) {
+			t.Errorf("Unexpected naked triple cells: %v", d.Cells)
+		}
+		if len(d.Digits) != 3 || d.Digits[0] != 1 || d.Digits[1] != 5 || d.Digits[2] != 8 {
+			t.Errorf("Unexpected naked triple digits: %v", d.Digits)
+		}
+		// 1 and 8 from (2, 4), along with 1, 5 and 8 from the 5 cells with all candidates.
+		if len(d.Eliminations) != 17 {
+			t.Errorf("Expected 17 eliminations, not %d.", len(d.Eliminations))
+		}
+		checkCandidates(t, b, 2, 4, []int{2, 3})
+		checkCandidates(t, b, 3, 4, []int{2, 3, 4, 6, 7, 9})
+		checkCandidates(t, b, 3, 5, []int{1, 2, 3, 4, 5, 6, 7, 8, 9})
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindNakedQuad(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(2, 2, []int{2, 3})
+		b.SetCandidates(3, 3, []int{3, 4})
+		b.SetCandidates(1, 3, []int{1, 4})
+		deductions := b.FindNakedSubsets(4)
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 naked quad, found %d.", len(deductions))
+		}
+		if deductions[0].Houses[0] != (House{BoxHouse, 1}) {
+			t.Errorf("Naked quad expected in box 1, not %s.", deductions[0].Houses[0])
+		}
+		checkCandidates(t, b, 2, 1, []int{5, 6, 7, 8, 9})
+		checkCandidates(t, b, 3, 2, []int{5, 6, 7, 8, 9})
+	} else {
+		t.Error(e.Error())
+	}
+}
@@ -0,0 +1,195 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// unionCandidates returns every value held as a candidate by the cells.
+func (b *Board) unionCandidates(cells []Location) []int {
+	var rtnval []int
+	for _, l := range cells {
+		for _, v := range b.cellCandidates(l) {
+			if !containsInt(rtnval, v) {
+				rtnval = append(rtnval, v)
+			}
+		}
+	}
+	sort.Ints(rtnval)
+	return rtnval
+}
+
+// intersects determines if two lists of values share a value.
+func intersects(a []int, b []int) bool {
+	for _, v := range a {
+		if containsInt(b, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// unsolvedOutside returns the undetermined cells of a house lying outside
+// another house, which hold at least one of the values.
+func (b *Board) unsolvedOutside(h House, other House, values []int) []Location {
+	var rtnval []Location
+	for _, l := range b.houseLocations(h) {
+		if !b.inHouse(l, other) && b.numPossibilities(l) > 0 && intersects(b.cellCandidates(l), values) {
+			rtnval = append(rtnval, l)
+		}
+	}
+	return rtnval
+}
+
+// sueDeCoqCells caps the cells a Sue de Coq takes from the rest of the line,
+// and from the rest of the box, keeping the search small on large boards.
+const sueDeCoqCells = 4
+
+// absorbed returns how many of the extra values of a Sue de Coq's intersection
+// a group of cells outside it takes up: one for each cell, less one for each
+// value the cells bring which the intersection does not hold.
+func (b *Board) absorbed(intersection []Location, values []int, cells []Location) int {
+	all := b.unionCandidates(append(append([]Location{}, intersection...), cells...))
+	return len(cells) - (len(all) - len(values))
+}
+
+// eliminateExcept eliminates each of the values from the cells of a house
+// other than the excluded ones, recording the eliminations in the deduction.
+func (b *Board) eliminateExcept(d *Deduction, h House, values []int, excluded []Location) {
+	for _, l := range b.houseLocations(h) {
+		if containsLocation(excluded, l) {
+			continue
+		}
+		for _, v := range values {
+			c := Candidate{l, v}
+			if b.hasCandidate(l, v) && !containsCandidate(d.Eliminations, c) {
+				d.Eliminations = append(d.Eliminations, c)
+			}
+		}
+	}
+}
+
+// sueDeCoq looks for a Sue de Coq on the intersection of a box and a line.
+func (b *Board) sueDeCoq(box House, line House) []Deduction {
+	var rtnval []Deduction
+	var intersection []Location
+	for _, l := range b.houseLocations(line) {
+		if b.inHouse(l, box) && b.numPossibilities(l) > 0 {
+			intersection = append(intersection, l)
+		}
+	}
+	for size := 2; size <= len(intersection); size++ {
+		combinations(len(intersection), size, func(indices []int) bool {
+			var cells []Location
+			for _, i := range indices {
+				cells = append(cells, intersection[i])
+			}
+			values := b.unionCandidates(cells)
+			extra := len(values) - len(cells)
+			if extra < 2 || extra > 2*sueDeCoqCells {
+				return true
+			}
+			// The line cells and box cells must between them take up every
+			// extra value, each taking at least one.  A cell absorbs at most
+			// one value, so a group falling too far short is grown no more,
+			// nor is a group already taking up all it can.  Nor is a box
+			// group once it matches, so each pattern is found at its
+			// smallest.  A larger pattern holding it adds cells which either
+			// hold a value the smaller pattern eliminates from them, or form
+			// a naked subset of their own, so nothing more is eliminated.
+			growSets(b.unsolvedOutside(line, box, values), sueDeCoqCells, func(lineCells []Location) bool {
+				lineAbsorbed := b.absorbed(cells, values, lineCells)
+				if lineAbsorbed+sueDeCoqCells-len(lineCells) < 1 {
+					return false
+				}
+				if lineAbsorbed < 1 {
+					return true
+				}
+				lineValues := b.unionCandidates(lineCells)
+				var candidates []Location
+				for _, l := range b.unsolvedOutside(box, line, values) {
+					if !intersects(b.cellCandidates(l), lineValues) {
+						candidates = append(candidates, l)
+					}
+				}
+				growSets(candidates, sueDeCoqCells, func(boxCells []Location) bool {
+					boxAbsorbed := b.absorbed(cells, values, boxCells)
+					if boxAbsorbed+sueDeCoqCells-len(boxCells) < extra-lineAbsorbed {
+						return false
+					}
+					if boxAbsorbed < extra-lineAbsorbed {
+						return true
+					}
+					if d, found := b.lockSueDeCoq(box, line, cells, lineCells, boxCells); found {
+						rtnval = append(rtnval, d)
+					}
+					return false
+				})
+				return lineAbsorbed < extra-1
+			})
+			return true
+		})
+	}
+	return rtnval
+}
+
+// lockSueDeCoq applies a Sue de Coq whose intersection, line and box cells
+// hold as many values as cells.  False is returned if the pattern no longer
+// holds or eliminates nothing.
+func (b *Board) lockSueDeCoq(box House, line House, cells []Location, lineCells []Location, boxCells []Location) (Deduction, bool) {
+	pattern := append(append(append([]Location{}, cells...), lineCells...), boxCells...)
+	for _, l := range pattern {
+		if b.numPossibilities(l) == 0 {
+			// Solved by an earlier elimination.
+			return Deduction{}, false
+		}
+	}
+	digits := b.unionCandidates(pattern)
+	if len(digits) != len(pattern) {
+		return Deduction{}, false
+	}
+	// Each value is placed exactly once within the pattern, so values not
+	// held by the box cells are placed in the line and values not held by the
+	// line cells in the box.
+	lineValues, boxValues := b.unionCandidates(lineCells), b.unionCandidates(boxCells)
+	var lineOnly, boxOnly []int
+	for _, v := range digits {
+		if !containsInt(boxValues, v) {
+			lineOnly = append(lineOnly, v)
+		}
+		if !containsInt(lineValues, v) {
+			boxOnly = append(boxOnly, v)
+		}
+	}
+	d := Deduction{Technique: "Sue de Coq", Cells: pattern, Houses: []House{box, line}, Digits: digits}
+	b.eliminateExcept(&d, line, lineOnly, pattern)
+	b.eliminateExcept(&d, box, boxOnly, pattern)
+	if len(d.Eliminations) == 0 {
+		return d, false
+	}
+	b.eliminate(d.Eliminations)
+	return d, true
+}
+
+// FindSueDeCoq looks for two or more cells where a box meets a row or column,
+// holding at least two more candidates than cells.  Should up to four further
+// cells of the line and up to four of the box, holding no value in common with
+// each other, bring the number of candidates of the whole group down to its
+// number of cells, each of those values is placed exactly once within the
+// group.  The values held by the line cells are eliminated from the rest of
+// the line, those held by the box cells from the rest of the box, and the
+// values held only by the intersection from the rest of both.
+func (b *Board) FindSueDeCoq() []Deduction {
+	var rtnval []Deduction
+	for boxNum := 1; boxNum <= b.maxValue; boxNum++ {
+		box := House{BoxHouse, boxNum}
+		boxCells := b.houseLocations(box)
+		first, last := boxCells[0], boxCells[len(boxCells)-1]
+		for row := first.Row; row <= last.Row; row++ {
+			rtnval = append(rtnval, b.sueDeCoq(box, House{RowHouse, row})...)
+		}
+		for column := first.Column; column <= last.Column; column++ {
+			rtnval = append(rtnval, b.sueDeCoq(box, House{ColumnHouse, column})...)
+		}
+	}
+	return rtnval
+}
@@ -0,0 +1,124 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestFindSueDeCoq(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// (1, 1) and (2, 1) hold 1 to 4 between them, (5, 1) holds 1 and 2
+		// and (1, 2) holds 3 and 4.
+		b.SetCandidates(1, 1, []int{1, 2, 3})
+		b.SetCandidates(2, 1, []int{1, 2, 4})
+		b.SetCandidates(3, 1, []int{5, 6, 7, 8, 9})
+		b.SetCandidates(5, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{3, 4})
+		deductions := b.FindSueDeCoq()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 Sue de Coq, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Sue de Coq" || len(d.Cells) != 4 || len(d.Digits) != 4 {
+			t.Errorf("Unexpected deduction: %s with %d cells and %d digits.", d.Technique, len(d.Cells), len(d.Digits))
+		}
+		if len(d.Houses) != 2 || d.Houses[0] != (House{BoxHouse, 1}) || d.Houses[1] != (House{RowHouse, 1}) {
+			t.Errorf("Unexpected houses: %v", d.Houses)
+		}
+		if len(d.Eliminations) != 20 {
+			t.Errorf("Expected 20 eliminations, not %d.", len(d.Eliminations))
+		}
+		for _, column := range []int{4, 6, 7, 8, 9} {
+			checkCandidates(t, b, column, 1, []int{3, 4, 5, 6, 7, 8, 9})
+		}
+		for _, l := range []Location{{2, 2}, {3, 2}, {1, 3}, {2, 3}, {3, 3}} {
+			checkCandidates(t, b, l.Column, l.Row, []int{1, 2, 5, 6, 7, 8, 9})
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindSueDeCoqSharedValue(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// The line and box cells share 2, so the group does not lock.
+		b.SetCandidates(1, 1, []int{1, 2, 3})
+		b.SetCandidates(2, 1, []int{1, 2, 4})
+		b.SetCandidates(3, 1, []int{5, 6, 7, 8, 9})
+		b.SetCandidates(5, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{2, 3, 4})
+		if deductions := b.FindSueDeCoq(); len(deductions) != 0 {
+			t.Errorf("Unexpected Sue de Coq: %v", deductions[0].Cells)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindSueDeCoqSmallestPattern(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		// Adding (6, 1), holding 2 and 5, to the line cells makes a larger
+		// pattern, but the smaller pattern eliminates 2 from it.
+		b.SetCandidates(1, 1, []int{1, 2, 3})
+		b.SetCandidates(2, 1, []int{1, 2, 4})
+		b.SetCandidates(3, 1, []int{5, 6, 7, 8, 9})
+		b.SetCandidates(5, 1, []int{1, 2})
+		b.SetCandidates(6, 1, []int{2, 5})
+		b.SetCandidates(1, 2, []int{3, 4})
+		deductions := b.FindSueDeCoq()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 Sue de Coq, found %d.", len(deductions))
+		}
+		if len(deductions[0].Cells) != 4 {
+			t.Errorf("Expected the pattern of 4 cells, not %v.", deductions[0].Cells)
+		}
+		if !containsCandidate(deductions[0].Eliminations, Candidate{Location{6, 1}, 2}) {
+			t.Errorf("2 not eliminated from (6, 1).")
+		}
+		if value, _ := b.GetValue(6, 1); value != 5 {
+			t.Errorf("Expected 5 at (6, 1), not %d.", value)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+// sueDeCoqLargeBoard returns a 16x16 board where every line cell outside box 1
+// holds 1 and 2, and every box cell outside row 1 holds 3 and 4, so most
+// groups of them take up the extra values of the intersection.  Searching
+// every such group would take minutes.
+func sueDeCoqLargeBoard(tb testing.TB) *Board {
+	b, e := NewBoard(4, NewBox, NewCell)
+	if e != nil {
+		tb.Fatal(e.Error())
+	}
+	b.SetCandidates(1, 1, []int{1, 2, 3, 4, 5, 6})
+	b.SetCandidates(2, 1, []int{1, 2, 3, 4, 5, 6})
+	for column := 5; column <= 16; column++ {
+		b.SetCandidates(column, 1, []int{1, 2})
+	}
+	for row := 2; row <= 4; row++ {
+		for column := 1; column <= 4; column++ {
+			b.SetCandidates(column, row, []int{3, 4})
+		}
+	}
+	return b
+}
+
+func TestFindSueDeCoqLargeBoard(t *testing.T) {
+	deductions := sueDeCoqLargeBoard(t).FindSueDeCoq()
+	if len(deductions) != 1 {
+		t.Errorf("Expected 1 Sue de Coq, found %d.", len(deductions))
+	}
+}
+
+func BenchmarkFindSueDeCoqLargeBoard(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		board := sueDeCoqLargeBoard(b)
+		b.StartTimer()
+		board.FindSueDeCoq()
+	}
+}
@@ -0,0 +1,94 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Alphabet lists the symbols standing for the values of a board, the first
+// symbol for 1, the second for 2 and so on.  A board holding maxValue values
+// uses the first maxValue symbols, so one alphabet serves boards of several
+// sizes.
+type Alphabet string
+
+// The alphabets of common puzzles.  DigitAlphabet writes a 9x9 board with the
+// digits 1-9, going on to 1-9A-G for a 16x16 board and 1-9A-P for a 25x25 one.
+const (
+	DigitAlphabet  Alphabet = "123456789ABCDEFGHIJKLMNOP"
+	HexAlphabet    Alphabet = "0123456789ABCDEF"
+	LetterAlphabet Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXY"
+)
+
+// blankSymbol is shown for an undetermined cell.
+const blankSymbol = "."
+
+// Symbol returns the symbol standing for a value, or "." for a value without
+// one, such as the -1 of an undetermined cell.
+func (a Alphabet) Symbol(value int) string {
+	symbols := []rune(string(a))
+	if value < 1 || value > len(symbols) {
+		return blankSymbol
+	}
+	return string(symbols[value-1])
+}
+
+// Value returns the value a symbol stands for, a lower case letter standing
+// for the same value as its upper case symbol.  An error is returned for a
+// symbol outside the alphabet.
+func (a Alphabet) Value(symbol rune) (int, error) {
+	symbols := []rune(string(a))
+	for _, s := range []rune{symbol, unicode.ToUpper(symbol)} {
+		for i, o := range symbols {
+			if o == s {
+				return i + 1, nil
+			}
+		}
+	}
+	msg := fmt.Sprintf("Symbol not in alphabet %s: %q", a, symbol)
+	return -1, errors.New(msg)
+}
+
+// valid checks an alphabet can write the values 1 to maxValue, holding enough
+// symbols without repeating one, in either case, or using the blank symbol.
+func (a Alphabet) valid(maxValue int) error {
+	symbols := []rune(string(a))
+	if len(symbols) < maxValue {
+		msg := fmt.Sprintf("Alphabet %s has fewer than %d symbols", a, maxValue)
+		return errors.New(msg)
+	}
+	for i, s := range symbols[:maxValue] {
+		if strings.ContainsRune(blankSymbol, s) || unicode.IsSpace(s) {
+			msg := fmt.Sprintf("Alphabet %s holds blank symbol %q", a, s)
+			return errors.New(msg)
+		}
+		for _, o := range symbols[:i] {
+			if unicode.ToUpper(o) == unicode.ToUpper(s) {
+				msg := fmt.Sprintf("Alphabet %s repeats symbol %q", a, s)
+				return errors.New(msg)
+			}
+		}
+	}
+	return nil
+}
+
+// Alphabet returns the symbols the board's values are written with.  Unless
+// set with SetAlphabet, the board uses the DigitAlphabet.
+func (b *Board) Alphabet() Alphabet {
+	if b.alphabet == "" {
+		return DigitAlphabet
+	}
+	return b.alphabet
+}
+
+// SetAlphabet sets the symbols the board's values are written with, by Print,
+// PrintPossibilities, hints and the board's other text formats.  An error is
+// returned if the alphabet can not write every value of the board.
+func (b *Board) SetAlphabet(a Alphabet) error {
+	if e := a.valid(b.maxValue); e != nil {
+		return e
+	}
+	b.alphabet = a
+	return nil
+}
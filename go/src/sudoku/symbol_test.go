@@ -0,0 +1,85 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestAlphabetSymbols(t *testing.T) {
+	for _, test := range []struct {
+		alphabet Alphabet
+		value    int
+		symbol   string
+	}{
+		{DigitAlphabet, 1, "1"}, {DigitAlphabet, 9, "9"}, {DigitAlphabet, 16, "G"}, {DigitAlphabet, 25, "P"},
+		{HexAlphabet, 1, "0"}, {HexAlphabet, 16, "F"}, {LetterAlphabet, 1, "A"}, {LetterAlphabet, 25, "Y"},
+	} {
+		if s := test.alphabet.Symbol(test.value); s != test.symbol {
+			t.Errorf("Expected %s for %d in %s, not %s.", test.symbol, test.value, test.alphabet, s)
+		}
+		if v, e := test.alphabet.Value([]rune(test.symbol)[0]); e != nil || v != test.value {
+			t.Errorf("Expected %d for %s in %s, not %d.", test.value, test.symbol, test.alphabet, v)
+		}
+	}
+	if s := DigitAlphabet.Symbol(-1); s != "." {
+		t.Errorf("Expected . for an undetermined cell, not %s.", s)
+	}
+	if v, e := HexAlphabet.Value('f'); e != nil || v != 16 {
+		t.Errorf("Expected 16 for f, not %d.", v)
+	}
+	if _, e := HexAlphabet.Value('G'); e == nil {
+		t.Error("Symbol outside the alphabet accepted.")
+	}
+}
+
+func TestSetAlphabet(t *testing.T) {
+	b, e := NewBoard(4, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if b.Alphabet() != DigitAlphabet {
+		t.Errorf("Expected the digit alphabet, not %s.", b.Alphabet())
+	}
+	for _, a := range []Alphabet{"123456789", "0123456789ABCDEa", "0123456789.BCDEF", "0123456789 BCDEF"} {
+		if b.SetAlphabet(a) == nil {
+			t.Errorf("Alphabet %s accepted.", a)
+		}
+	}
+	if e := b.SetAlphabet(HexAlphabet); e != nil {
+		t.Fatal(e.Error())
+	}
+	clone, e := b.Clone()
+	if e != nil || clone.Alphabet() != HexAlphabet {
+		t.Error("Alphabet not copied by Clone.")
+	}
+
+	b, e = NewBoard(5, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	if b.SetAlphabet(HexAlphabet) == nil {
+		t.Error("Hex alphabet accepted for a 25x25 board.")
+	}
+	if e := b.SetAlphabet(LetterAlphabet); e != nil {
+		t.Error(e.Error())
+	}
+}
+
+func TestHintAlphabet(t *testing.T) {
+	b, e := NewBoard(4, NewBox, NewCell)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	for col := 1; col <= 15; col++ {
+		b.SetValue(col, 1, col)
+	}
+	b.SetAlphabet(HexAlphabet)
+	b.SetStrategies(NewRegistry(NewStrategy("Naked Single", 1.2, eachCell("Naked Single", (*Board).FindHiddenSingle, nakedSingleHouses))))
+	h, e := b.Hint()
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	expected := "Naked Single of F in row 1, column 16 and box 4 places F at (16, 1)."
+	if h.Explanation != expected {
+		t.Errorf("Unexpected explanation: %s", h.Explanation)
+	}
+}
@@ -0,0 +1,432 @@
+package sudoku
+
+import (
+	"errors"
+	"fmt"
+)
+
+// rectangle is four undetermined cells at the corners formed by two rows and
+// two columns, spanning exactly two boxes.  Should all four hold only the same
+// two values the puzzle would have two solutions, so on a puzzle with a unique
+// solution this deadly pattern can not occur.
+type rectangle struct {
+	corners [4]Location // (c1, r1), (c2, r1), (c1, r2), (c2, r2)
+	x       int
+	y       int
+}
+
+// opposite returns the corner diagonally opposite corner i.
+func (r rectangle) opposite(i int) Location {
+	return r.corners[3-i]
+}
+
+// extras returns the candidates of a corner other than x and y.
+func (b *Board) extras(r rectangle, l Location) []int {
+	var rtnval []int
+	for _, v := range b.cellCandidates(l) {
+		if v != r.x && v != r.y {
+			rtnval = append(rtnval, v)
+		}
+	}
+	return rtnval
+}
+
+// rectangles returns every rectangle whose corners all hold both x and y as
+// candidates.
+func (b *Board) rectangles() []rectangle {
+	var rtnval []rectangle
+	for r1 := 1; r1 <= b.maxValue; r1++ {
+		for r2 := r1 + 1; r2 <= b.maxValue; r2++ {
+			for c1 := 1; c1 <= b.maxValue; c1++ {
+				for c2 := c1 + 1; c2 <= b.maxValue; c2++ {
+					corners := [4]Location{{c1, r1}, {c2, r1}, {c1, r2}, {c2, r2}}
+					boxes := make(map[int]bool)
+					for _, l := range corners {
+						boxes[b.boxOf(l)] = true
+					}
+					if len(boxes) != 2 {
+						continue
+					}
+					for x := 1; x <= b.maxValue; x++ {
+						for y := x + 1; y <= b.maxValue; y++ {
+							all := true
+							for _, l := range corners {
+								all = all && b.hasCandidate(l, x) && b.hasCandidate(l, y)
+							}
+							if all {
+								rtnval = append(rtnval, rectangle{corners, x, y})
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// sharedHouses returns the houses containing both of two cells.
+func (b *Board) sharedHouses(l1 Location, l2 Location) []House {
+	var rtnval []House
+	if l1.Row == l2.Row {
+		rtnval = append(rtnval, House{RowHouse, l1.Row})
+	}
+	if l1.Column == l2.Column {
+		rtnval = append(rtnval, House{ColumnHouse, l1.Column})
+	}
+	if b.boxOf(l1) == b.boxOf(l2) {
+		rtnval = append(rtnval, House{BoxHouse, b.boxOf(l1)})
+	}
+	return rtnval
+}
+
+// sameLocations determines if two lists hold the same locations in any order.
+func sameLocations(l1 []Location, l2 []Location) bool {
+	if len(l1) != len(l2) {
+		return false
+	}
+	for _, l := range l1 {
+		found := false
+		for _, o := range l2 {
+			found = found || l == o
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// FindUniqueRectangles looks for rectangles which would become deadly patterns,
+// and eliminates the candidates that would complete them.  Unique rectangle
+// types 1 through 6 and hidden unique rectangles are looked for.  Nothing is
+// done unless the puzzle has been asserted to have a unique solution with
+// AssumeUniqueSolution.
+func (b *Board) FindUniqueRectangles() []Deduction {
+	if !b.uniqueSolution {
+		return nil
+	}
+	var rtnval []Deduction
+	for _, r := range b.rectangles() {
+		// The rectangle may no longer exist due to earlier eliminations.
+		intact := true
+		for _, l := range r.corners {
+			intact = intact && b.hasCandidate(l, r.x) && b.hasCandidate(l, r.y)
+		}
+		if !intact {
+			continue
+		}
+
+		var floor, roof []Location
+		for _, l := range r.corners {
+			if b.numPossibilities(l) == 2 {
+				floor = append(floor, l)
+			} else {
+				roof = append(roof, l)
+			}
+		}
+		d := Deduction{Cells: r.corners[:], Digits: []int{r.x, r.y}}
+
+		var found []*Deduction
+		switch len(floor) {
+		case 3:
+			found = append(found, b.uniqueRectangleType1(r, d, roof[0]))
+		case 2:
+			aligned := floor[0].Row == floor[1].Row || floor[0].Column == floor[1].Column
+			if aligned {
+				found = append(found, b.uniqueRectangleType2(r, d, roof))
+				found = append(found, b.uniqueRectangleType3(r, d, roof)...)
+				found = append(found, b.uniqueRectangleType4(r, d, roof))
+			} else {
+				found = append(found, b.uniqueRectangleType5(r, d, roof))
+				found = append(found, b.uniqueRectangleType6(r, d, roof))
+			}
+		case 1:
+			found = append(found, b.uniqueRectangleType5(r, d, roof))
+		}
+		if len(floor) >= 1 {
+			found = append(found, b.hiddenUniqueRectangle(r, d)...)
+		}
+		for _, f := range found {
+			if f != nil {
+				rtnval = append(rtnval, *f)
+			}
+		}
+	}
+	return rtnval
+}
+
+// uniqueRectangleType1 handles three corners holding only x and y.  The fourth
+// corner must avoid the deadly pattern, so x and y are eliminated from it.
+func (b *Board) uniqueRectangleType1(r rectangle, d Deduction, roof Location) *Deduction {
+	d.Technique = "Unique Rectangle Type 1"
+	found := b.eliminateCells(d, r.x, []Location{roof})
+	if found != nil {
+		d = *found
+	}
+	return b.eliminateCells(d, r.y, []Location{roof})
+}
+
+// uniqueRectangleType2 handles two roof corners in the same row or column
+// holding the same single extra candidate z.  One of them must hold z, so z is
+// eliminated from every cell seeing both.
+func (b *Board) uniqueRectangleType2(r rectangle, d Deduction, roof []Location) *Deduction {
+	e1, e2 := b.extras(r, roof[0]), b.extras(r, roof[1])
+	if len(e1) != 1 || len(e2) != 1 || e1[0] != e2[0] {
+		return nil
+	}
+	d.Technique = "Unique Rectangle Type 2"
+	return b.eliminateSeen(d, e1[0], roof)
+}
+
+// uniqueRectangleType3 handles two roof corners in the same row, column or box
+// whose extra candidates, combined with other cells of that house, form a
+// naked subset.  The roof corners act as a single cell holding one of the
+// extra candidates.
+func (b *Board) uniqueRectangleType3(r rectangle, d Deduction, roof []Location) []*Deduction {
+	var rtnval []*Deduction
+	extras := b.extras(r, roof[0])
+	for _, v := range b.extras(r, roof[1]) {
+		if !containsInt(extras, v) {
+			extras = append(extras, v)
+		}
+	}
+	if len(extras) < 2 {
+		return nil
+	}
+	for _, h := range b.sharedHouses(roof[0], roof[1]) {
+		var others []Location
+		for _, l := range b.houseLocations(h) {
+			if l != roof[0] && l != roof[1] && b.numPossibilities(l) > 0 {
+				others = append(others, l)
+			}
+		}
+		for size := 1; size <= 3; size++ {
+			combinations(len(others), size, func(indices []int) bool {
+				digits := append([]int{}, extras...)
+				var cells []Location
+				for _, i := range indices {
+					if b.numPossibilities(others[i]) == 0 {
+						// Solved by an earlier subset of this house.
+						return true
+					}
+					cells = append(cells, others[i])
+					for _, v := range b.cellCandidates(others[i]) {
+						if !containsInt(digits, v) {
+							digits = append(digits, v)
+						}
+					}
+				}
+				if len(digits) != size+1 {
+					return true
+				}
+				found := Deduction{Technique: "Unique Rectangle Type 3", Cells: append(d.Cells[:4:4], cells...), Houses: []House{h}, Digits: digits}
+				for _, l := range others {
+					if containsLocation(cells, l) {
+						continue
+					}
+					for _, v := range digits {
+						if b.hasCandidate(l, v) {
+							found.Eliminations = append(found.Eliminations, Candidate{l, v})
+						}
+					}
+				}
+				if len(found.Eliminations) > 0 {
+					b.eliminate(found.Eliminations)
+					rtnval = append(rtnval, &found)
+				}
+				return true
+			})
+		}
+	}
+	return rtnval
+}
+
+// uniqueRectangleType4 handles two roof corners in the same row, column or box
+// where that house has no other place for x.  One of the roof corners holds x,
+// so neither can hold y without forming the deadly pattern.
+func (b *Board) uniqueRectangleType4(r rectangle, d Deduction, roof []Location) *Deduction {
+	for _, h := range b.sharedHouses(roof[0], roof[1]) {
+		for _, v := range []int{r.x, r.y} {
+			other := r.x + r.y - v
+			if b.valuePlaced(h, v) || !sameLocations(b.candidateLocations(h, v), roof) {
+				continue
+			}
+			d.Technique = "Unique Rectangle Type 4"
+			d.Houses = []House{h}
+			found := b.eliminateCells(d, other, roof)
+			if found != nil {
+				return found
+			}
+		}
+	}
+	return nil
+}
+
+// uniqueRectangleType5 handles the corners other than those holding only x and
+// y sharing a single extra candidate z.  One of them must hold z, so z is
+// eliminated from every cell seeing all of them.
+func (b *Board) uniqueRectangleType5(r rectangle, d Deduction, roof []Location) *Deduction {
+	z := 0
+	for _, l := range roof {
+		e := b.extras(r, l)
+		if len(e) != 1 || (z != 0 && e[0] != z) {
+			return nil
+		}
+		z = e[0]
+	}
+	d.Technique = "Unique Rectangle Type 5"
+	return b.eliminateSeen(d, z, roof)
+}
+
+// uniqueRectangleType6 handles diagonal roof corners where x can only be
+// placed in the rectangle within both rows, or both columns.  x would then fill
+// either both roof corners or both floor corners, and filling the roof leaves
+// the floor corners with y forming the deadly pattern.  So x is eliminated from
+// the roof corners.
+func (b *Board) uniqueRectangleType6(r rectangle, d Deduction, roof []Location) *Deduction {
+	c := r.corners
+	lines := [][2]House{
+		{{RowHouse, c[0].Row}, {RowHouse, c[2].Row}},
+		{{ColumnHouse, c[0].Column}, {ColumnHouse, c[1].Column}},
+	}
+	for _, v := range []int{r.x, r.y} {
+		for _, pair := range lines {
+			locked := true
+			for _, h := range pair {
+				cells := b.candidateLocations(h, v)
+				locked = locked && !b.valuePlaced(h, v) && len(cells) == 2 && b.inRectangle(r, cells[0]) && b.inRectangle(r, cells[1])
+			}
+			if locked {
+				d.Technique = "Unique Rectangle Type 6"
+				d.Houses = pair[:]
+				found := b.eliminateCells(d, v, roof)
+				if found != nil {
+					return found
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// hiddenUniqueRectangle handles a corner holding only x and y whose opposite
+// corner has extra candidates.  If the opposite corner's row and column have no
+// other place for x outside of the rectangle, the opposite corner holding y
+// would force the deadly pattern, so y is eliminated from it.
+func (b *Board) hiddenUniqueRectangle(r rectangle, d Deduction) []*Deduction {
+	var rtnval []*Deduction
+	for i, l := range r.corners {
+		if b.numPossibilities(l) != 2 {
+			continue
+		}
+		o := r.opposite(i)
+		if b.numPossibilities(o) == 2 {
+			continue
+		}
+		for _, v := range []int{r.x, r.y} {
+			row, column := House{RowHouse, o.Row}, House{ColumnHouse, o.Column}
+			locked := !b.valuePlaced(row, v) && !b.valuePlaced(column, v)
+			for _, cell := range append(b.candidateLocations(row, v), b.candidateLocations(column, v)...) {
+				locked = locked && b.inRectangle(r, cell)
+			}
+			if locked {
+				d.Technique = "Hidden Unique Rectangle"
+				d.Houses = []House{row, column}
+				found := b.eliminateCells(d, r.x+r.y-v, []Location{o})
+				if found != nil {
+					rtnval = append(rtnval, found)
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// inRectangle determines if a location is one of the corners of a rectangle.
+func (b *Board) inRectangle(r rectangle, l Location) bool {
+	return containsLocation(r.corners[:], l)
+}
+
+// containsLocation determines if a location is a member of locations.
+func containsLocation(locations []Location, l Location) bool {
+	for _, o := range locations {
+		if o == l {
+			return true
+		}
+	}
+	return false
+}
+
+// FindBUG looks for a Bivalue Universal Grave plus one: every undetermined cell
+// holds two candidates except for one cell holding three.  Without that cell's
+// extra candidate the puzzle would have two solutions, so the cell must hold
+// the candidate appearing three times in its row, column or box.  Candidates
+// whose value is already placed in one of the cell's houses are ignored, as
+// they may not have been eliminated yet.  Nothing is done unless the puzzle has
+// been asserted to have a unique solution with AssumeUniqueSolution.
+func (b *Board) FindBUG() []Deduction {
+	if !b.uniqueSolution {
+		return nil
+	}
+	candidates := make(map[Location][]int)
+	var extra []Location
+	for _, l := range b.unsolvedLocations() {
+		candidates[l] = b.unplacedCandidates(l)
+		switch len(candidates[l]) {
+		case 2:
+		case 3:
+			extra = append(extra, l)
+		default:
+			return nil
+		}
+	}
+	if len(extra) != 1 {
+		return nil
+	}
+	l := extra[0]
+	for _, h := range []House{{RowHouse, l.Row}, {ColumnHouse, l.Column}, {BoxHouse, b.boxOf(l)}} {
+		for _, v := range candidates[l] {
+			count := 0
+			for _, o := range b.houseLocations(h) {
+				if containsInt(candidates[o], v) {
+					count++
+				}
+			}
+			if count == 3 {
+				d := Deduction{Technique: "BUG+1", Cells: []Location{l}, Houses: []House{h}, Digits: []int{v}}
+				d.Placements = []Candidate{{l, v}}
+				if e := b.place(d.Placements[0]); e != nil {
+					return nil
+				}
+				return []Deduction{d}
+			}
+		}
+	}
+	return nil
+}
+
+// unplacedCandidates returns the candidates of the cell at a location whose
+// value is not placed in any of the cell's houses.
+func (b *Board) unplacedCandidates(l Location) []int {
+	var rtnval []int
+	for _, v := range b.cellCandidates(l) {
+		if _, placed := b.placedPeer(Candidate{l, v}); !placed {
+			rtnval = append(rtnval, v)
+		}
+	}
+	return rtnval
+}
+
+// place sets the value of the cell at a candidate's location.
+func (b *Board) place(c Candidate) error {
+	cell, e := b.getCell(c.Column, c.Row)
+	if e != nil {
+		return e
+	}
+	if !cell.Contains(c.Value) {
+		msg := fmt.Sprintf("%d is not a candidate at %s", c.Value, c.Location)
+		return errors.New(msg)
+	}
+	return cell.SetValue(c.Value)
+}
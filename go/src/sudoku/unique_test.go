@@ -0,0 +1,244 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestUniqueRectangleRequiresUniqueness(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{1, 2})
+		b.SetCandidates(4, 2, []int{1, 2, 5})
+		if len(b.FindUniqueRectangles()) != 0 || len(b.FindBUG()) != 0 {
+			t.Errorf("Uniqueness techniques used without assuming a unique solution.")
+		}
+		if !b.hasCandidate(Location{4, 2}, 1) {
+			t.Errorf("1 eliminated from (4, 2) without assuming a unique solution.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestUniqueRectangleType1(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.AssumeUniqueSolution(true)
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{1, 2})
+		b.SetCandidates(4, 2, []int{1, 2, 5})
+		deductions := b.FindUniqueRectangles()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 unique rectangle, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Unique Rectangle Type 1" || len(d.Eliminations) != 2 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+		value, _ := b.GetValue(4, 2)
+		if value != 5 {
+			t.Errorf("Expected 5 at (4, 2), not %d.", value)
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestUniqueRectangleType2(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.AssumeUniqueSolution(true)
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{1, 2, 5})
+		b.SetCandidates(4, 2, []int{1, 2, 5})
+		deductions := b.FindUniqueRectangles()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 unique rectangle, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Unique Rectangle Type 2" || len(d.Eliminations) != 7 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{9, 2}, 5) {
+			t.Errorf("5 not eliminated from (9, 2).")
+		}
+		if !b.hasCandidate(Location{1, 2}, 5) || !b.hasCandidate(Location{1, 3}, 5) {
+			t.Errorf("5 eliminated from a cell not seeing both roof cells.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestUniqueRectangleType3(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.AssumeUniqueSolution(true)
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{1, 2, 5})
+		b.SetCandidates(4, 2, []int{1, 2, 6})
+		b.SetCandidates(7, 2, []int{5, 6})
+		deductions := b.FindUniqueRectangles()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 unique rectangle, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Unique Rectangle Type 3" || len(d.Eliminations) != 12 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+		checkCandidates(t, b, 2, 2, []int{1, 2, 3, 4, 7, 8, 9})
+		checkCandidates(t, b, 7, 2, []int{5, 6})
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestUniqueRectangleType4(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.AssumeUniqueSolution(true)
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2})
+		b.SetCandidates(1, 2, []int{1, 2, 5})
+		b.SetCandidates(4, 2, []int{1, 2, 6})
+		restrictValue(b, 2, 1, []int{1, 4})
+		deductions := b.FindUniqueRectangles()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 unique rectangle, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "Unique Rectangle Type 4" || len(d.Eliminations) != 2 {
+			t.Errorf("Unexpected deduction: %s with %d eliminations.", d.Technique, len(d.Eliminations))
+		}
+		checkCandidates(t, b, 1, 2, []int{1, 5})
+		checkCandidates(t, b, 4, 2, []int{1, 6})
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestHiddenUniqueRectangle(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.AssumeUniqueSolution(true)
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(4, 1, []int{1, 2, 7})
+		b.SetCandidates(1, 2, []int{1, 2, 8})
+		b.SetCandidates(4, 2, []int{1, 2, 9})
+		restrictValue(b, 2, 1, []int{1, 4})
+		restrictColumnValue(b, 4, 1, []int{1, 2})
+		deductions := b.FindUniqueRectangles()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 unique rectangle, found %d.", len(deductions))
+		}
+		if deductions[0].Technique != "Hidden Unique Rectangle" {
+			t.Errorf("Unexpected technique %s.", deductions[0].Technique)
+		}
+		checkCandidates(t, b, 4, 2, []int{1, 9})
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+// bugBoard returns the solution of the difficult board with the first three
+// cells of the first row left undetermined.
+func bugBoard() [][]int {
+	values := make([][]int, len(difficultBoardSolution))
+	for i, row := range difficultBoardSolution {
+		values[i] = append([]int{}, row...)
+	}
+	values[0][0], values[0][1], values[0][2] = -1, -1, -1
+	return values
+}
+
+// bugLine is a puzzle left by the solver with every undetermined cell holding
+// two candidates but (7, 9), whose value is 5.  Blank cells hold every
+// candidate not already placed in their houses, other than (7, 8) and (9, 8)
+// which need setting by bugBoardFromLine.
+const bugLine = "357182.6.286954317149763..86132978..59864173247283519692.376.817..5.8...8..4.9.7."
+
+// bugBoardFromLine returns the board of bugLine, with the candidates of its
+// blank cells left unreduced by the values placed in their houses.
+func bugBoardFromLine(t *testing.T) *Board {
+	b, e := NewBoardFromLine(bugLine, DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	b.AssumeUniqueSolution(true)
+	b.SetCandidates(7, 8, []int{6, 9})
+	b.SetCandidates(9, 8, []int{3, 9})
+	return b
+}
+
+func TestFindBUG(t *testing.T) {
+	b := bugBoardFromLine(t)
+	deductions := b.FindBUG()
+	if len(deductions) != 1 {
+		t.Fatalf("Expected BUG+1, found %d deductions.", len(deductions))
+	}
+	d := deductions[0]
+	if len(d.Placements) != 1 || d.Placements[0] != (Candidate{Location{7, 9}, 5}) {
+		t.Errorf("Unexpected placements %v.", d.Placements)
+	}
+	value, _ := b.GetValue(7, 9)
+	if value != 5 {
+		t.Errorf("Expected 5 at (7, 9), not %d.", value)
+	}
+}
+
+func TestFindBUGTwoExtraCells(t *testing.T) {
+	b := bugBoardFromLine(t)
+	b.SetCandidates(9, 8, []int{3, 4, 9})
+	if len(b.FindBUG()) != 0 {
+		t.Errorf("BUG+1 found with two cells holding three candidates.")
+	}
+}
+
+func TestFindBUGIgnoresPlacedValues(t *testing.T) {
+	line := "6.....8.3.4.7.................5.4.7.3..2.....1.6.......2.....5.....8.6......1...."
+	solution, e := NewBoardFromLine(line, DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	solution.Solve()
+	b, _ := NewBoardFromLine(line, DigitAlphabet)
+	b.AssumeUniqueSolution(true)
+	for pass := 0; pass < 14; pass++ {
+		b.SinglePassSolve()
+	}
+	for _, d := range b.FindBUG() {
+		for _, c := range d.Placements {
+			value, _ := solution.GetValue(c.Column, c.Row)
+			if value != c.Value {
+				t.Errorf("%s placed %s, not the value %d of the solution.", d.Technique, c, value)
+			}
+		}
+	}
+}
+
+func TestUniqueRectangleType3IgnoresSolvedCells(t *testing.T) {
+	line := "48.3............71.2.......7.5....6....2..8.............1.76...3.....4......5...."
+	solution, e := NewBoardFromLine(line, DigitAlphabet)
+	if e != nil {
+		t.Fatal(e.Error())
+	}
+	solution.Solve()
+	b, _ := NewBoardFromLine(line, DigitAlphabet)
+	b.AssumeUniqueSolution(true)
+	for pass := 0; pass < 7; pass++ {
+		b.SinglePassSolve()
+	}
+	for _, d := range b.FindUniqueRectangles() {
+		for _, c := range d.Eliminations {
+			value, _ := solution.GetValue(c.Column, c.Row)
+			if value == c.Value {
+				t.Errorf("%s eliminated %s, the value of the solution.", d.Technique, c)
+			}
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package sudoku
+
+import (
+	"sort"
+)
+
+// sees determines if two different cells share a row, column or box, in which
+// case they can not both hold the same value.
+func (b *Board) sees(l1 Location, l2 Location) bool {
+	if l1 == l2 {
+		return false
+	}
+	return l1.Row == l2.Row || l1.Column == l2.Column || b.boxOf(l1) == b.boxOf(l2)
+}
+
+// seesAll determines if a cell sees every one of the listed cells.
+func (b *Board) seesAll(l Location, others []Location) bool {
+	for _, o := range others {
+		if !b.sees(l, o) {
+			return false
+		}
+	}
+	return true
+}
+
+// unsolvedLocations returns the location of every undetermined cell of the
+// board, row by row.
+func (b *Board) unsolvedLocations() []Location {
+	var rtnval []Location
+	for row := 1; row <= b.maxValue; row++ {
+		for column := 1; column <= b.maxValue; column++ {
+			cell, e := b.getCell(column, row)
+			if e == nil && !cell.Determined() {
+				rtnval = append(rtnval, Location{column, row})
+			}
+		}
+	}
+	return rtnval
+}
+
+// cellCandidates returns the candidates of the cell at a location in
+// increasing order.
+func (b *Board) cellCandidates(l Location) []int {
+	cell, e := b.getCell(l.Column, l.Row)
+	if e != nil || cell.Determined() {
+		return nil
+	}
+	candidates := cell.GetCandidates().GetAllMembers()
+	sort.Ints(candidates)
+	return candidates
+}
+
+// numPossibilities returns the number of candidates of the cell at a location.
+func (b *Board) numPossibilities(l Location) int {
+	cell, e := b.getCell(l.Column, l.Row)
+	if e != nil || cell.Determined() {
+		return 0
+	}
+	return cell.NumPossibilities()
+}
+
+// eliminateSeen eliminates value from every cell that sees all of the seen
+// cells, recording the eliminations in the deduction.  Nil is returned if
+// nothing could be eliminated.
+func (b *Board) eliminateSeen(d Deduction, value int, seen []Location) *Deduction {
+	for _, l := range b.unsolvedLocations() {
+		if b.hasCandidate(l, value) && b.seesAll(l, seen) {
+			d.Eliminations = append(d.Eliminations, Candidate{l, value})
+		}
+	}
+	if len(d.Eliminations) == 0 {
+		return nil
+	}
+	b.eliminate(d.Eliminations)
+	return &d
+}
+
+// otherValue returns the candidate of a bivalue cell which is not value.
+func otherValue(candidates []int, value int) int {
+	if candidates[0] == value {
+		return candidates[1]
+	}
+	return candidates[0]
+}
+
+// FindXYWing looks for a bivalue pivot cell holding x and y which sees two
+// bivalue pincer cells, one holding x and z and the other y and z.  Whatever
+// the pivot holds, one of the pincers holds z, so z is eliminated from every
+// cell seeing both pincers.
+func (b *Board) FindXYWing() []Deduction {
+	var rtnval []Deduction
+	var bivalue []Location
+	for _, l := range b.unsolvedLocations() {
+		if b.numPossibilities(l) == 2 {
+			bivalue = append(bivalue, l)
+		}
+	}
+	for _, pivot := range bivalue {
+		pc := b.cellCandidates(pivot)
+		if len(pc) != 2 {
+			continue
+		}
+		x, y := pc[0], pc[1]
+		for _, p1 := range bivalue {
+			c1 := b.cellCandidates(p1)
+			if len(c1) != 2 || !b.sees(pivot, p1) || !containsInt(c1, x) || containsInt(c1, y) {
+				continue
+			}
+			z := otherValue(c1, x)
+			for _, p2 := range bivalue {
+				c2 := b.cellCandidates(p2)
+				if len(c2) != 2 || !b.sees(pivot, p2) || !containsInt(c2, y) || !containsInt(c2, z) {
+					continue
+				}
+				d := Deduction{Technique: "XY-Wing", Cells: []Location{pivot, p1, p2}, Digits: []int{x, y, z}}
+				found := b.eliminateSeen(d, z, []Location{p1, p2})
+				if found != nil {
+					rtnval = append(rtnval, *found)
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindXYZWing looks for a pivot cell holding exactly x, y and z which sees two
+// bivalue pincer cells, one holding x and z and the other y and z.  One of the
+// three cells must hold z, so z is eliminated from every cell seeing all three.
+func (b *Board) FindXYZWing() []Deduction {
+	var rtnval []Deduction
+	var bivalue []Location
+	var trivalue []Location
+	for _, l := range b.unsolvedLocations() {
+		switch b.numPossibilities(l) {
+		case 2:
+			bivalue = append(bivalue, l)
+		case 3:
+			trivalue = append(trivalue, l)
+		}
+	}
+	for _, pivot := range trivalue {
+		pc := b.cellCandidates(pivot)
+		if len(pc) != 3 {
+			continue
+		}
+		for _, z := range pc {
+			var pincers []Location
+			for _, p := range bivalue {
+				c := b.cellCandidates(p)
+				if len(c) == 2 && b.sees(pivot, p) && containsInt(c, z) && containsInt(pc, otherValue(c, z)) {
+					pincers = append(pincers, p)
+				}
+			}
+			for i := 0; i < len(pincers); i++ {
+				for j := i + 1; j < len(pincers); j++ {
+					p1, p2 := pincers[i], pincers[j]
+					if otherValue(b.cellCandidates(p1), z) == otherValue(b.cellCandidates(p2), z) {
+						continue
+					}
+					d := Deduction{Technique: "XYZ-Wing", Cells: []Location{pivot, p1, p2}, Digits: pc}
+					found := b.eliminateSeen(d, z, d.Cells)
+					if found != nil {
+						rtnval = append(rtnval, *found)
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindWWing looks for two bivalue cells holding the same x and y which do not
+// see each other, but are joined by a strong link on x: a house where x can
+// only be placed in two cells, one seeing each of the bivalue cells.  One of
+// the bivalue cells must then hold y, so y is eliminated from every cell seeing
+// both of them.
+func (b *Board) FindWWing() []Deduction {
+	var rtnval []Deduction
+	var bivalue []Location
+	for _, l := range b.unsolvedLocations() {
+		if b.numPossibilities(l) == 2 {
+			bivalue = append(bivalue, l)
+		}
+	}
+	for i := 0; i < len(bivalue); i++ {
+		for j := i + 1; j < len(bivalue); j++ {
+			w1, w2 := bivalue[i], bivalue[j]
+			c1, c2 := b.cellCandidates(w1), b.cellCandidates(w2)
+			if len(c1) != 2 || len(c2) != 2 || c1[0] != c2[0] || c1[1] != c2[1] || b.sees(w1, w2) {
+				continue
+			}
+			for _, x := range c1 {
+				y := otherValue(c1, x)
+				for _, h := range b.houses() {
+					if b.valuePlaced(h, x) {
+						continue
+					}
+					link := b.candidateLocations(h, x)
+					if len(link) != 2 || link[0] == w1 || link[0] == w2 || link[1] == w1 || link[1] == w2 {
+						continue
+					}
+					if !(b.sees(link[0], w1) && b.sees(link[1], w2)) && !(b.sees(link[0], w2) && b.sees(link[1], w1)) {
+						continue
+					}
+					d := Deduction{Technique: "W-Wing", Cells: []Location{w1, w2, link[0], link[1]}, Houses: []House{h}, Digits: []int{x, y}}
+					found := b.eliminateSeen(d, y, []Location{w1, w2})
+					if found != nil {
+						rtnval = append(rtnval, *found)
+					}
+				}
+			}
+		}
+	}
+	return rtnval
+}
+
+// FindWings looks for XY-Wings, XYZ-Wings and W-Wings.
+func (b *Board) FindWings() []Deduction {
+	rtnval := b.FindXYWing()
+	rtnval = append(rtnval, b.FindXYZWing()...)
+	return append(rtnval, b.FindWWing()...)
+}
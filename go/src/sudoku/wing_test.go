@@ -0,0 +1,79 @@
+package sudoku
+
+import (
+	"testing"
+)
+
+func TestFindXYWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2})
+		b.SetCandidates(5, 1, []int{1, 3})
+		b.SetCandidates(1, 5, []int{2, 3})
+		deductions := b.FindXYWing()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 XY-Wing, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Cells[0] != (Location{1, 1}) || len(d.Eliminations) != 1 {
+			t.Errorf("Unexpected XY-Wing: pivot %s with %d eliminations.", d.Cells[0], len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{5, 5}, 3) {
+			t.Errorf("3 not eliminated from (5, 5).")
+		}
+		if len(b.FindXYZWing()) != 0 || len(b.FindWWing()) != 0 {
+			t.Errorf("Other wings found where only an XY-Wing exists.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindXYZWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{1, 2, 3})
+		b.SetCandidates(2, 2, []int{1, 3})
+		b.SetCandidates(5, 1, []int{2, 3})
+		deductions := b.FindXYZWing()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 XYZ-Wing, found %d.", len(deductions))
+		}
+		if len(deductions[0].Eliminations) != 2 {
+			t.Errorf("Expected 2 eliminations, not %d.", len(deductions[0].Eliminations))
+		}
+		if b.hasCandidate(Location{2, 1}, 3) || b.hasCandidate(Location{3, 1}, 3) {
+			t.Errorf("3 not eliminated from the cells seeing all of the XYZ-Wing.")
+		}
+		if !b.hasCandidate(Location{4, 1}, 3) {
+			t.Errorf("3 eliminated from a cell not seeing the pincer at (2, 2).")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}
+
+func TestFindWWing(t *testing.T) {
+	b, e := NewBoard(3, NewBox, NewCell)
+	if e == nil {
+		b.SetCandidates(1, 1, []int{4, 7})
+		b.SetCandidates(5, 9, []int{4, 7})
+		restrictValue(b, 5, 4, []int{1, 5})
+		deductions := b.FindWings()
+		if len(deductions) != 1 {
+			t.Fatalf("Expected 1 W-Wing, found %d.", len(deductions))
+		}
+		d := deductions[0]
+		if d.Technique != "W-Wing" || d.Houses[0] != (House{RowHouse, 5}) {
+			t.Errorf("Unexpected deduction: %s in %v", d.Technique, d.Houses)
+		}
+		if len(d.Eliminations) != 2 {
+			t.Errorf("Expected 2 eliminations, not %d.", len(d.Eliminations))
+		}
+		if b.hasCandidate(Location{1, 9}, 7) || b.hasCandidate(Location{5, 1}, 7) {
+			t.Errorf("7 not eliminated from the cells seeing both wings.")
+		}
+	} else {
+		t.Error(e.Error())
+	}
+}